@@ -2,6 +2,7 @@ package common
 
 import (
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -38,6 +39,173 @@ type DevFlagsSpec struct {
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
 }
 
+// PodOverrides defines extra configuration applied to the containers of all the Deployments of a component.
+// +kubebuilder:object:generate=true
+type PodOverrides struct {
+	// List of environment variables to set in the containers of the component. A variable
+	// already defined by the component with the same name is overridden.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// List of sources to populate environment variables in the containers of the component.
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// List of volumes to add to the pods of the component. Only ConfigMap, Secret and EmptyDir volumes are supported.
+	// +optional
+	Volumes []PodOverridesVolume `json:"volumes,omitempty"`
+	// List of volumes to mount into the containers of the component.
+	// +optional
+	VolumeMounts []PodOverridesVolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// PodOverridesVolume is a volume added to the pods of a component.
+// +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="[has(self.configMap), has(self.secret), has(self.emptyDir)].filter(x, x).size() == 1",message="exactly one of configMap, secret or emptyDir must be set"
+type PodOverridesVolume struct {
+	// Name of the volume, must not conflict with the volumes of the component.
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// ConfigMap represents a configMap that should populate this volume.
+	// +optional
+	ConfigMap *corev1.ConfigMapVolumeSource `json:"configMap,omitempty"`
+	// Secret represents a secret that should populate this volume.
+	// +optional
+	Secret *corev1.SecretVolumeSource `json:"secret,omitempty"`
+	// EmptyDir represents a temporary directory that shares a pod's lifetime.
+	// +optional
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+}
+
+// PodOverridesVolumeMount describes the mounting of one of the volumes of the pod overrides.
+// +kubebuilder:object:generate=true
+type PodOverridesVolumeMount struct {
+	// Name of the volume to mount, must match one of the volumes of the pod overrides.
+	Name string `json:"name"`
+	// Path within the containers at which the volume is mounted, must be under /mnt/.
+	// +kubebuilder:validation:Pattern="^/mnt/.+$"
+	MountPath string `json:"mountPath"`
+	// Path within the volume from which the container's volume should be mounted. Defaults to "" (volume's root).
+	// +optional
+	SubPath string `json:"subPath,omitempty"`
+	// Mounted read-only if true, read-write otherwise.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// PodOverridesSpec struct defines the component's pod overrides configuration.
+// +kubebuilder:object:generate=true
+type PodOverridesSpec struct {
+	// Extra configuration applied to the pods of the component
+	// +optional
+	PodOverrides *PodOverrides `json:"podOverrides,omitempty"`
+}
+
+// AcceleratorDefaults maps an accelerator to the scheduling constraints added by default to the serving
+// runtimes and training jobs using it, e.g. to tolerate the taints of the GPU node pools.
+// +kubebuilder:object:generate=true
+type AcceleratorDefaults struct {
+	// Identifier of the accelerator, as set in its AcceleratorProfile (e.g. nvidia.com/gpu). The defaults apply
+	// to the workloads requesting this resource or recommending this accelerator.
+	// +kubebuilder:validation:MinLength=1
+	Identifier string `json:"identifier"`
+	// Tolerations added to the workloads, unless already defined.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Node labels added to the node selector of the workloads, a label already set by the workload takes precedence.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// AcceleratorDefaultsSpec struct defines the default scheduling constraints of accelerated workloads.
+// +kubebuilder:object:generate=true
+type AcceleratorDefaultsSpec struct {
+	// Default scheduling constraints of the serving runtimes and training jobs, per accelerator
+	// +optional
+	// +listType=map
+	// +listMapKey=identifier
+	AcceleratorDefaults []AcceleratorDefaults `json:"acceleratorDefaults,omitempty"`
+}
+
+// Scaling configures a fixed number of replicas of the controllers of a component. The controllers
+// are leader-elected, so the additional replicas are standbys that take over when the leader fails,
+// for high availability, rather than sharing the load.
+// +kubebuilder:object:generate=true
+type Scaling struct {
+	// Fixed number of replicas of the controllers
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// ScalingSpec struct defines the component's scaling configuration.
+// +kubebuilder:object:generate=true
+type ScalingSpec struct {
+	// Number of replicas of the controllers of the component
+	// +optional
+	Scaling *Scaling `json:"scaling,omitempty"`
+}
+
+// ResourceHealth describes the health of an object deployed for a component.
+// +kubebuilder:validation:Enum=Healthy;Unhealthy;Unknown
+type ResourceHealth string
+
+const (
+	ResourceHealthy   ResourceHealth = "Healthy"
+	ResourceUnhealthy ResourceHealth = "Unhealthy"
+	ResourceUnknown   ResourceHealth = "Unknown"
+)
+
+// ResourceStatus reports an object the operator manages for a component.
+// +kubebuilder:object:generate=true
+type ResourceStatus struct {
+	// +optional
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Health of the object. Objects without a notion of health, like ConfigMaps or RBAC resources,
+	// are Healthy as long as they exist.
+	Health ResourceHealth `json:"health"`
+	// Human-readable details about the health of the object
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResourcesStatus struct defines the inventory of the objects managed for a component, it is only
+// reported in the status of the component resource.
+// +kubebuilder:object:generate=true
+type ResourcesStatus struct {
+	// List of the objects the operator manages for the component, with their health
+	// +optional
+	// +listType=atomic
+	Resources []ResourceStatus `json:"resources,omitempty"`
+}
+
+// ResourcesSummary summarizes the health of the objects managed for a component.
+// +kubebuilder:object:generate=true
+type ResourcesSummary struct {
+	// Number of objects the operator manages for the component
+	Total int32 `json:"total"`
+	// Number of objects which are not Healthy
+	Unhealthy int32 `json:"unhealthy"`
+	// The objects which are not Healthy, the full inventory is reported in the status of the component resource
+	// +optional
+	// +listType=atomic
+	UnhealthyResources []ResourceStatus `json:"unhealthyResources,omitempty"`
+}
+
+// ResourcesSummaryStatus struct defines the summary of the inventory of a component, as exposed in the
+// status of the DataScienceCluster.
+// +kubebuilder:object:generate=true
+type ResourcesSummaryStatus struct {
+	// Summary of the health of the objects the operator manages for the component
+	// +optional
+	ResourcesSummary *ResourcesSummary `json:"resourcesSummary,omitempty"`
+}
+
 type ManifestsConfig struct {
 	// uri is the URI point to a git repo with tag/branch. e.g.  https://github.com/org/repo/tarball/<tag/branch>
 	// +optional
@@ -78,6 +246,23 @@ type WithDevFlags interface {
 	GetDevFlags() *DevFlags
 }
 
+type WithPodOverrides interface {
+	GetPodOverrides() *PodOverrides
+}
+
+type WithScaling interface {
+	GetScaling() *Scaling
+}
+
+type WithAcceleratorDefaults interface {
+	GetAcceleratorDefaults() []AcceleratorDefaults
+}
+
+type WithResourcesStatus interface {
+	GetResourcesStatus() *ResourcesStatus
+	GetResourcesSummaryStatus() *ResourcesSummaryStatus
+}
+
 type PlatformObject interface {
 	client.Object
 	WithStatus
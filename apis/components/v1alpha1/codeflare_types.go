@@ -30,12 +30,14 @@ const (
 
 // CodeFlareCommonStatus defines the shared observed state of CodeFlare
 type CodeFlareCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // CodeFlareStatus defines the observed state of CodeFlare
 type CodeFlareStatus struct {
-	common.Status         `json:",inline"`
-	CodeFlareCommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	CodeFlareCommonStatus  `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -59,17 +61,30 @@ type CodeFlareSpec struct {
 }
 
 type CodeFlareCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 func (c *CodeFlare) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *CodeFlare) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
 func (c *CodeFlare) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *CodeFlare) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *CodeFlare) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 func init() {
 	SchemeBuilder.Register(&CodeFlare{}, &CodeFlareList{})
 }
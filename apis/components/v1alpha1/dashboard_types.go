@@ -32,7 +32,8 @@ const (
 // DashboardCommonSpec spec defines the shared desired state of Dashboard
 type DashboardCommonSpec struct {
 	// dashboard spec exposed to DSC api
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 	// dashboard spec exposed only to internal api
 }
 
@@ -45,13 +46,15 @@ type DashboardSpec struct {
 
 // DashboardCommonStatus defines the shared observed state of Dashboard
 type DashboardCommonStatus struct {
-	URL string `json:"url,omitempty"`
+	common.ResourcesSummaryStatus `json:",inline"`
+	URL                           string `json:"url,omitempty"`
 }
 
 // DashboardStatus defines the observed state of Dashboard
 type DashboardStatus struct {
-	common.Status         `json:",inline"`
-	DashboardCommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	DashboardCommonStatus  `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -75,10 +78,22 @@ func (c *Dashboard) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *Dashboard) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
 func (c *Dashboard) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *Dashboard) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *Dashboard) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // +kubebuilder:object:root=true
 
 // DashboardList contains a list of Dashboard
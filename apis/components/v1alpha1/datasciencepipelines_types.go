@@ -50,16 +50,20 @@ type DataSciencePipelinesSpec struct {
 }
 
 type DataSciencePipelinesCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
+	common.ScalingSpec      `json:",inline"`
 }
 
 // DataSciencePipelinesCommonStatus defines the shared observed state of DataSciencePipelines
 type DataSciencePipelinesCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // DataSciencePipelinesStatus defines the observed state of DataSciencePipelines
 type DataSciencePipelinesStatus struct {
 	common.Status                    `json:",inline"`
+	common.ResourcesStatus           `json:",inline"`
 	DataSciencePipelinesCommonStatus `json:",inline"`
 }
 
@@ -67,10 +71,26 @@ func (c *DataSciencePipelines) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *DataSciencePipelines) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
+func (c *DataSciencePipelines) GetScaling() *common.Scaling {
+	return c.Spec.Scaling
+}
+
 func (c *DataSciencePipelines) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *DataSciencePipelines) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *DataSciencePipelines) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // +kubebuilder:object:root=true
 
 // DataSciencePipelinesList contains a list of DataSciencePipelines
@@ -43,7 +43,9 @@ const (
 
 // KserveCommonSpec spec defines the shared desired state of Kserve
 type KserveCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
+	common.ScalingSpec      `json:",inline"`
 	// Serving configures the KNative-Serving stack used for model serving. A Service
 	// Mesh (Istio) is prerequisite, since it is used as networking layer.
 	Serving infrav1.ServingSpec `json:"serving,omitempty"`
@@ -74,12 +76,14 @@ type KserveSpec struct {
 
 // KserveCommonStatus defines the shared observed state of Kserve
 type KserveCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // KserveStatus defines the observed state of Kserve
 type KserveStatus struct {
-	common.Status      `json:",inline"`
-	KserveCommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	KserveCommonStatus     `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -102,10 +106,26 @@ func (c *Kserve) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *Kserve) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
+func (c *Kserve) GetScaling() *common.Scaling {
+	return c.Spec.Scaling
+}
+
 func (c *Kserve) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *Kserve) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *Kserve) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // +kubebuilder:object:root=true
 
 // KserveList contains a list of Kserve
@@ -52,17 +52,20 @@ type KueueSpec struct {
 }
 
 type KueueCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 // KueueCommonStatus defines the shared observed state of Kueue
 type KueueCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // KueueStatus defines the observed state of Kueue
 type KueueStatus struct {
-	common.Status     `json:",inline"`
-	KueueCommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	KueueCommonStatus      `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -80,10 +83,22 @@ func init() {
 func (c *Kueue) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
+
+func (c *Kueue) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
 func (c *Kueue) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *Kueue) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *Kueue) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // DSCKueue contains all the configuration exposed in DSC instance for Kueue component
 type DSCKueue struct {
 	common.ManagementSpec `json:",inline"`
@@ -54,6 +54,8 @@ type ModelControllerSpec struct {
 	// ModelMeshServing DSCModelMeshServing `json:"modelMeshServing,omitempty"`
 	Kserve           *ModelControllerKerveSpec `json:"kserve,omitempty"`
 	ModelMeshServing *ModelControllerMMSpec    `json:"modelMeshServing,omitempty"`
+	// default scheduling constraints of the serving runtimes shipped by the model controller
+	common.AcceleratorDefaultsSpec `json:",inline"`
 }
 
 // a mini version of the DSCKserve only keep devflags and management spec
@@ -96,6 +98,10 @@ func init() {
 
 func (c *ModelController) GetDevFlags() *common.DevFlags { return nil }
 
+func (c *ModelController) GetAcceleratorDefaults() []common.AcceleratorDefaults {
+	return c.Spec.AcceleratorDefaults
+}
+
 func (c *ModelController) GetStatus() *common.Status {
 	return &c.Status.Status
 }
@@ -52,16 +52,19 @@ type ModelMeshServingSpec struct {
 }
 
 type ModelMeshServingCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 // ModelMeshServingCommonStatus defines the shared observed state of ModelMeshServing
 type ModelMeshServingCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // ModelMeshServingStatus defines the observed state of ModelMeshServing
 type ModelMeshServingStatus struct {
 	common.Status                `json:",inline"`
+	common.ResourcesStatus       `json:",inline"`
 	ModelMeshServingCommonStatus `json:",inline"`
 }
 
@@ -80,10 +83,22 @@ func init() {
 func (c *ModelMeshServing) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
+
+func (c *ModelMeshServing) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
 func (c *ModelMeshServing) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *ModelMeshServing) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *ModelMeshServing) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // DSCModelMeshServing contains all the configuration exposed in DSC instance for ModelMeshServing component
 type DSCModelMeshServing struct {
 	common.ManagementSpec `json:",inline"`
@@ -32,7 +32,8 @@ const (
 // ModelRegistryCommonSpec spec defines the shared desired state of ModelRegistry
 type ModelRegistryCommonSpec struct {
 	// model registry spec exposed to DSC api
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 
 	// Namespace for model registries to be installed, configurable only once when model registry is enabled, defaults to "odh-model-registries"
 	// +kubebuilder:default="odh-model-registries"
@@ -50,12 +51,14 @@ type ModelRegistrySpec struct {
 
 // ModelRegistryCommonStatus defines the shared observed state of ModelRegistry
 type ModelRegistryCommonStatus struct {
-	RegistriesNamespace string `json:"registriesNamespace,omitempty"`
+	common.ResourcesSummaryStatus `json:",inline"`
+	RegistriesNamespace           string `json:"registriesNamespace,omitempty"`
 }
 
 // ModelRegistryStatus defines the observed state of ModelRegistry
 type ModelRegistryStatus struct {
 	common.Status             `json:",inline"`
+	common.ResourcesStatus    `json:",inline"`
 	ModelRegistryCommonStatus `json:",inline"`
 }
 
@@ -79,10 +82,22 @@ func (c *ModelRegistry) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *ModelRegistry) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
 func (c *ModelRegistry) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *ModelRegistry) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *ModelRegistry) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // +kubebuilder:object:root=true
 
 // ModelRegistryList contains a list of ModelRegistry
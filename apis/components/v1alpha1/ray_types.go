@@ -52,17 +52,20 @@ type RaySpec struct {
 }
 
 type RayCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 // RayCommonStatus defines the shared observed state of Ray
 type RayCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // RayStatus defines the observed state of Ray
 type RayStatus struct {
-	common.Status   `json:",inline"`
-	RayCommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	RayCommonStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -80,10 +83,22 @@ func init() {
 func (c *Ray) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
+
+func (c *Ray) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
 func (c *Ray) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *Ray) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *Ray) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // DSCRay contains all the configuration exposed in DSC instance for Ray component
 type DSCRay struct {
 	common.ManagementSpec `json:",inline"`
@@ -48,20 +48,24 @@ type TrainingOperator struct {
 
 // TrainingOperatorSpec defines the desired state of TrainingOperator
 type TrainingOperatorSpec struct {
-	TrainingOperatorCommonSpec `json:",inline"`
+	TrainingOperatorCommonSpec     `json:",inline"`
+	common.AcceleratorDefaultsSpec `json:",inline"`
 }
 
 type TrainingOperatorCommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 // TrainingOperatorCommonStatus defines the shared observed state of TrainingOperator
 type TrainingOperatorCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // TrainingOperatorStatus defines the observed state of TrainingOperator
 type TrainingOperatorStatus struct {
 	common.Status                `json:",inline"`
+	common.ResourcesStatus       `json:",inline"`
 	TrainingOperatorCommonStatus `json:",inline"`
 }
 
@@ -80,10 +84,27 @@ func init() {
 func (c *TrainingOperator) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
+
+func (c *TrainingOperator) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
+func (c *TrainingOperator) GetAcceleratorDefaults() []common.AcceleratorDefaults {
+	return c.Spec.AcceleratorDefaults
+}
+
 func (c *TrainingOperator) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *TrainingOperator) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *TrainingOperator) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // DSCTrainingOperator contains all the configuration exposed in DSC instance for TrainingOperator component
 type DSCTrainingOperator struct {
 	common.ManagementSpec `json:",inline"`
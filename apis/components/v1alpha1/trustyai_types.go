@@ -52,17 +52,20 @@ type TrustyAISpec struct {
 }
 
 type TrustyAICommonSpec struct {
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
 }
 
 // TrustyAICommonStatus defines the shared observed state of TrustyAI
 type TrustyAICommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // TrustyAIStatus defines the observed state of TrustyAI
 type TrustyAIStatus struct {
-	common.Status        `json:",inline"`
-	TrustyAICommonStatus `json:",inline"`
+	common.Status          `json:",inline"`
+	common.ResourcesStatus `json:",inline"`
+	TrustyAICommonStatus   `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -80,10 +83,22 @@ func init() {
 func (c *TrustyAI) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
+
+func (c *TrustyAI) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
 func (c *TrustyAI) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *TrustyAI) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *TrustyAI) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // DSCTrustyAI contains all the configuration exposed in DSC instance for TrustyAI component
 type DSCTrustyAI struct {
 	common.ManagementSpec `json:",inline"`
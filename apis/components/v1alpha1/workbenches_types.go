@@ -31,7 +31,9 @@ const (
 
 type WorkbenchesCommonSpec struct {
 	// workbenches spec exposed to DSC api
-	common.DevFlagsSpec `json:",inline"`
+	common.DevFlagsSpec     `json:",inline"`
+	common.PodOverridesSpec `json:",inline"`
+	common.ScalingSpec      `json:",inline"`
 	// workbenches spec exposed only to internal api
 }
 
@@ -44,11 +46,13 @@ type WorkbenchesSpec struct {
 
 // WorkbenchesCommonStatus defines the shared observed state of Workbenches
 type WorkbenchesCommonStatus struct {
+	common.ResourcesSummaryStatus `json:",inline"`
 }
 
 // WorkbenchesStatus defines the observed state of Workbenches
 type WorkbenchesStatus struct {
 	common.Status           `json:",inline"`
+	common.ResourcesStatus  `json:",inline"`
 	WorkbenchesCommonStatus `json:",inline"`
 }
 
@@ -72,10 +76,26 @@ func (c *Workbenches) GetDevFlags() *common.DevFlags {
 	return c.Spec.DevFlags
 }
 
+func (c *Workbenches) GetPodOverrides() *common.PodOverrides {
+	return c.Spec.PodOverrides
+}
+
+func (c *Workbenches) GetScaling() *common.Scaling {
+	return c.Spec.Scaling
+}
+
 func (c *Workbenches) GetStatus() *common.Status {
 	return &c.Status.Status
 }
 
+func (c *Workbenches) GetResourcesStatus() *common.ResourcesStatus {
+	return &c.Status.ResourcesStatus
+}
+
+func (c *Workbenches) GetResourcesSummaryStatus() *common.ResourcesSummaryStatus {
+	return &c.Status.ResourcesSummaryStatus
+}
+
 // +kubebuilder:object:root=true
 
 // WorkbenchesList contains a list of Workbenches
@@ -55,6 +55,7 @@ func (in *CodeFlare) DeepCopyObject() runtime.Object {
 func (in *CodeFlareCommonSpec) DeepCopyInto(out *CodeFlareCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeFlareCommonSpec.
@@ -70,6 +71,7 @@ func (in *CodeFlareCommonSpec) DeepCopy() *CodeFlareCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CodeFlareCommonStatus) DeepCopyInto(out *CodeFlareCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeFlareCommonStatus.
@@ -134,7 +136,8 @@ func (in *CodeFlareSpec) DeepCopy() *CodeFlareSpec {
 func (in *CodeFlareStatus) DeepCopyInto(out *CodeFlareStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.CodeFlareCommonStatus = in.CodeFlareCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.CodeFlareCommonStatus.DeepCopyInto(&out.CodeFlareCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeFlareStatus.
@@ -171,7 +174,7 @@ func (in *DSCCodeFlareStatus) DeepCopyInto(out *DSCCodeFlareStatus) {
 	if in.CodeFlareCommonStatus != nil {
 		in, out := &in.CodeFlareCommonStatus, &out.CodeFlareCommonStatus
 		*out = new(CodeFlareCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -209,7 +212,7 @@ func (in *DSCDashboardStatus) DeepCopyInto(out *DSCDashboardStatus) {
 	if in.DashboardCommonStatus != nil {
 		in, out := &in.DashboardCommonStatus, &out.DashboardCommonStatus
 		*out = new(DashboardCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -247,7 +250,7 @@ func (in *DSCDataSciencePipelinesStatus) DeepCopyInto(out *DSCDataSciencePipelin
 	if in.DataSciencePipelinesCommonStatus != nil {
 		in, out := &in.DataSciencePipelinesCommonStatus, &out.DataSciencePipelinesCommonStatus
 		*out = new(DataSciencePipelinesCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -285,7 +288,7 @@ func (in *DSCKserveStatus) DeepCopyInto(out *DSCKserveStatus) {
 	if in.KserveCommonStatus != nil {
 		in, out := &in.KserveCommonStatus, &out.KserveCommonStatus
 		*out = new(KserveCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -323,7 +326,7 @@ func (in *DSCKueueStatus) DeepCopyInto(out *DSCKueueStatus) {
 	if in.KueueCommonStatus != nil {
 		in, out := &in.KueueCommonStatus, &out.KueueCommonStatus
 		*out = new(KueueCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -361,7 +364,7 @@ func (in *DSCModelMeshServingStatus) DeepCopyInto(out *DSCModelMeshServingStatus
 	if in.ModelMeshServingCommonStatus != nil {
 		in, out := &in.ModelMeshServingCommonStatus, &out.ModelMeshServingCommonStatus
 		*out = new(ModelMeshServingCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -399,7 +402,7 @@ func (in *DSCModelRegistryStatus) DeepCopyInto(out *DSCModelRegistryStatus) {
 	if in.ModelRegistryCommonStatus != nil {
 		in, out := &in.ModelRegistryCommonStatus, &out.ModelRegistryCommonStatus
 		*out = new(ModelRegistryCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -437,7 +440,7 @@ func (in *DSCRayStatus) DeepCopyInto(out *DSCRayStatus) {
 	if in.RayCommonStatus != nil {
 		in, out := &in.RayCommonStatus, &out.RayCommonStatus
 		*out = new(RayCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -475,7 +478,7 @@ func (in *DSCTrainingOperatorStatus) DeepCopyInto(out *DSCTrainingOperatorStatus
 	if in.TrainingOperatorCommonStatus != nil {
 		in, out := &in.TrainingOperatorCommonStatus, &out.TrainingOperatorCommonStatus
 		*out = new(TrainingOperatorCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -513,7 +516,7 @@ func (in *DSCTrustyAIStatus) DeepCopyInto(out *DSCTrustyAIStatus) {
 	if in.TrustyAICommonStatus != nil {
 		in, out := &in.TrustyAICommonStatus, &out.TrustyAICommonStatus
 		*out = new(TrustyAICommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -551,7 +554,7 @@ func (in *DSCWorkbenchesStatus) DeepCopyInto(out *DSCWorkbenchesStatus) {
 	if in.WorkbenchesCommonStatus != nil {
 		in, out := &in.WorkbenchesCommonStatus, &out.WorkbenchesCommonStatus
 		*out = new(WorkbenchesCommonStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -596,6 +599,7 @@ func (in *Dashboard) DeepCopyObject() runtime.Object {
 func (in *DashboardCommonSpec) DeepCopyInto(out *DashboardCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCommonSpec.
@@ -611,6 +615,7 @@ func (in *DashboardCommonSpec) DeepCopy() *DashboardCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardCommonStatus) DeepCopyInto(out *DashboardCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCommonStatus.
@@ -675,7 +680,8 @@ func (in *DashboardSpec) DeepCopy() *DashboardSpec {
 func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.DashboardCommonStatus = in.DashboardCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.DashboardCommonStatus.DeepCopyInto(&out.DashboardCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
@@ -719,6 +725,8 @@ func (in *DataSciencePipelines) DeepCopyObject() runtime.Object {
 func (in *DataSciencePipelinesCommonSpec) DeepCopyInto(out *DataSciencePipelinesCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
+	in.ScalingSpec.DeepCopyInto(&out.ScalingSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSciencePipelinesCommonSpec.
@@ -734,6 +742,7 @@ func (in *DataSciencePipelinesCommonSpec) DeepCopy() *DataSciencePipelinesCommon
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSciencePipelinesCommonStatus) DeepCopyInto(out *DataSciencePipelinesCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSciencePipelinesCommonStatus.
@@ -798,7 +807,8 @@ func (in *DataSciencePipelinesSpec) DeepCopy() *DataSciencePipelinesSpec {
 func (in *DataSciencePipelinesStatus) DeepCopyInto(out *DataSciencePipelinesStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.DataSciencePipelinesCommonStatus = in.DataSciencePipelinesCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.DataSciencePipelinesCommonStatus.DeepCopyInto(&out.DataSciencePipelinesCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSciencePipelinesStatus.
@@ -842,6 +852,8 @@ func (in *Kserve) DeepCopyObject() runtime.Object {
 func (in *KserveCommonSpec) DeepCopyInto(out *KserveCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
+	in.ScalingSpec.DeepCopyInto(&out.ScalingSpec)
 	out.Serving = in.Serving
 	out.NIM = in.NIM
 }
@@ -859,6 +871,7 @@ func (in *KserveCommonSpec) DeepCopy() *KserveCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KserveCommonStatus) DeepCopyInto(out *KserveCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveCommonStatus.
@@ -923,7 +936,8 @@ func (in *KserveSpec) DeepCopy() *KserveSpec {
 func (in *KserveStatus) DeepCopyInto(out *KserveStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.KserveCommonStatus = in.KserveCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.KserveCommonStatus.DeepCopyInto(&out.KserveCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveStatus.
@@ -967,6 +981,7 @@ func (in *Kueue) DeepCopyObject() runtime.Object {
 func (in *KueueCommonSpec) DeepCopyInto(out *KueueCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCommonSpec.
@@ -982,6 +997,7 @@ func (in *KueueCommonSpec) DeepCopy() *KueueCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueCommonStatus) DeepCopyInto(out *KueueCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCommonStatus.
@@ -1046,7 +1062,8 @@ func (in *KueueSpec) DeepCopy() *KueueSpec {
 func (in *KueueStatus) DeepCopyInto(out *KueueStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.KueueCommonStatus = in.KueueCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.KueueCommonStatus.DeepCopyInto(&out.KueueCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueStatus.
@@ -1164,6 +1181,7 @@ func (in *ModelControllerSpec) DeepCopyInto(out *ModelControllerSpec) {
 		*out = new(ModelControllerMMSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	in.AcceleratorDefaultsSpec.DeepCopyInto(&out.AcceleratorDefaultsSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelControllerSpec.
@@ -1223,6 +1241,7 @@ func (in *ModelMeshServing) DeepCopyObject() runtime.Object {
 func (in *ModelMeshServingCommonSpec) DeepCopyInto(out *ModelMeshServingCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelMeshServingCommonSpec.
@@ -1238,6 +1257,7 @@ func (in *ModelMeshServingCommonSpec) DeepCopy() *ModelMeshServingCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelMeshServingCommonStatus) DeepCopyInto(out *ModelMeshServingCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelMeshServingCommonStatus.
@@ -1302,7 +1322,8 @@ func (in *ModelMeshServingSpec) DeepCopy() *ModelMeshServingSpec {
 func (in *ModelMeshServingStatus) DeepCopyInto(out *ModelMeshServingStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.ModelMeshServingCommonStatus = in.ModelMeshServingCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.ModelMeshServingCommonStatus.DeepCopyInto(&out.ModelMeshServingCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelMeshServingStatus.
@@ -1346,6 +1367,7 @@ func (in *ModelRegistry) DeepCopyObject() runtime.Object {
 func (in *ModelRegistryCommonSpec) DeepCopyInto(out *ModelRegistryCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryCommonSpec.
@@ -1361,6 +1383,7 @@ func (in *ModelRegistryCommonSpec) DeepCopy() *ModelRegistryCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelRegistryCommonStatus) DeepCopyInto(out *ModelRegistryCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryCommonStatus.
@@ -1425,7 +1448,8 @@ func (in *ModelRegistrySpec) DeepCopy() *ModelRegistrySpec {
 func (in *ModelRegistryStatus) DeepCopyInto(out *ModelRegistryStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.ModelRegistryCommonStatus = in.ModelRegistryCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.ModelRegistryCommonStatus.DeepCopyInto(&out.ModelRegistryCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryStatus.
@@ -1484,6 +1508,7 @@ func (in *Ray) DeepCopyObject() runtime.Object {
 func (in *RayCommonSpec) DeepCopyInto(out *RayCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCommonSpec.
@@ -1499,6 +1524,7 @@ func (in *RayCommonSpec) DeepCopy() *RayCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayCommonStatus) DeepCopyInto(out *RayCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCommonStatus.
@@ -1563,7 +1589,8 @@ func (in *RaySpec) DeepCopy() *RaySpec {
 func (in *RayStatus) DeepCopyInto(out *RayStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.RayCommonStatus = in.RayCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.RayCommonStatus.DeepCopyInto(&out.RayCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayStatus.
@@ -1607,6 +1634,7 @@ func (in *TrainingOperator) DeepCopyObject() runtime.Object {
 func (in *TrainingOperatorCommonSpec) DeepCopyInto(out *TrainingOperatorCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorCommonSpec.
@@ -1622,6 +1650,7 @@ func (in *TrainingOperatorCommonSpec) DeepCopy() *TrainingOperatorCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrainingOperatorCommonStatus) DeepCopyInto(out *TrainingOperatorCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorCommonStatus.
@@ -1670,6 +1699,7 @@ func (in *TrainingOperatorList) DeepCopyObject() runtime.Object {
 func (in *TrainingOperatorSpec) DeepCopyInto(out *TrainingOperatorSpec) {
 	*out = *in
 	in.TrainingOperatorCommonSpec.DeepCopyInto(&out.TrainingOperatorCommonSpec)
+	in.AcceleratorDefaultsSpec.DeepCopyInto(&out.AcceleratorDefaultsSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorSpec.
@@ -1686,7 +1716,8 @@ func (in *TrainingOperatorSpec) DeepCopy() *TrainingOperatorSpec {
 func (in *TrainingOperatorStatus) DeepCopyInto(out *TrainingOperatorStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.TrainingOperatorCommonStatus = in.TrainingOperatorCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.TrainingOperatorCommonStatus.DeepCopyInto(&out.TrainingOperatorCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorStatus.
@@ -1730,6 +1761,7 @@ func (in *TrustyAI) DeepCopyObject() runtime.Object {
 func (in *TrustyAICommonSpec) DeepCopyInto(out *TrustyAICommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAICommonSpec.
@@ -1745,6 +1777,7 @@ func (in *TrustyAICommonSpec) DeepCopy() *TrustyAICommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustyAICommonStatus) DeepCopyInto(out *TrustyAICommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAICommonStatus.
@@ -1809,7 +1842,8 @@ func (in *TrustyAISpec) DeepCopy() *TrustyAISpec {
 func (in *TrustyAIStatus) DeepCopyInto(out *TrustyAIStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.TrustyAICommonStatus = in.TrustyAICommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.TrustyAICommonStatus.DeepCopyInto(&out.TrustyAICommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAIStatus.
@@ -1853,6 +1887,8 @@ func (in *Workbenches) DeepCopyObject() runtime.Object {
 func (in *WorkbenchesCommonSpec) DeepCopyInto(out *WorkbenchesCommonSpec) {
 	*out = *in
 	in.DevFlagsSpec.DeepCopyInto(&out.DevFlagsSpec)
+	in.PodOverridesSpec.DeepCopyInto(&out.PodOverridesSpec)
+	in.ScalingSpec.DeepCopyInto(&out.ScalingSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesCommonSpec.
@@ -1868,6 +1904,7 @@ func (in *WorkbenchesCommonSpec) DeepCopy() *WorkbenchesCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkbenchesCommonStatus) DeepCopyInto(out *WorkbenchesCommonStatus) {
 	*out = *in
+	in.ResourcesSummaryStatus.DeepCopyInto(&out.ResourcesSummaryStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesCommonStatus.
@@ -1932,7 +1969,8 @@ func (in *WorkbenchesSpec) DeepCopy() *WorkbenchesSpec {
 func (in *WorkbenchesStatus) DeepCopyInto(out *WorkbenchesStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.WorkbenchesCommonStatus = in.WorkbenchesCommonStatus
+	in.ResourcesStatus.DeepCopyInto(&out.ResourcesStatus)
+	in.WorkbenchesCommonStatus.DeepCopyInto(&out.WorkbenchesCommonStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesStatus.
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 )
@@ -30,6 +31,11 @@ type DataScienceClusterSpec struct {
 	// Override and fine tune specific component configurations.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
 	Components Components `json:"components,omitempty"`
+
+	// Default scheduling constraints of the serving runtimes and training jobs using an accelerator, applied
+	// to the runtimes shipped by the model controller of KServe and ModelMesh Serving, and to the jobs
+	// submitted to the Training Operator.
+	common.AcceleratorDefaultsSpec `json:",inline"`
 }
 
 type Components struct {
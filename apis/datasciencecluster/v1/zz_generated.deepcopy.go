@@ -141,6 +141,7 @@ func (in *DataScienceClusterList) DeepCopyObject() runtime.Object {
 func (in *DataScienceClusterSpec) DeepCopyInto(out *DataScienceClusterSpec) {
 	*out = *in
 	in.Components.DeepCopyInto(&out.Components)
+	in.AcceleratorDefaultsSpec.DeepCopyInto(&out.AcceleratorDefaultsSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterSpec.
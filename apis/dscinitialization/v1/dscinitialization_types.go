@@ -56,9 +56,21 @@ type DSCInitializationSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
 	// +optional
 	TrustedCABundle *TrustedCABundleSpec `json:"trustedCABundle,omitempty"`
+	// Configures where the credentials generated by the operator for the components and capabilities are kept.
+	// By default they are stored in Kubernetes Secrets, alternatively they can be sourced from
+	// an external secret manager (e.g. Vault) through the External Secrets Operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
+	// +optional
+	SecretBackend *SecretBackendSpec `json:"secretBackend,omitempty"`
+	// Restricts the roll out of changes to the components and capabilities to maintenance windows.
+	// Outside of their windows, upgrades and configuration changes are deferred while the health
+	// of the deployed resources is still reconciled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=6
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
 	// Internal development useful field to test customizations.
 	// This is not recommended to be used in production environment.
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=7
 	// +optional
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
 }
@@ -90,6 +102,81 @@ type TrustedCABundleSpec struct {
 	CustomCABundle string `json:"customCABundle"`
 }
 
+// SecretBackendType is the kind of store the generated credentials are kept in.
+type SecretBackendType string
+
+const (
+	// SecretBackendKubernetes stores the generated credentials in Kubernetes Secrets.
+	SecretBackendKubernetes SecretBackendType = "Kubernetes"
+	// SecretBackendExternalSecrets sources the credentials from a store of the External Secrets Operator.
+	SecretBackendExternalSecrets SecretBackendType = "ExternalSecrets"
+)
+
+// SecretBackendSpec configures where the credentials generated by the operator are kept.
+// +kubebuilder:validation:XValidation:rule="self.type != 'ExternalSecrets' || has(self.externalSecrets)",message="externalSecrets must be set when type is ExternalSecrets"
+type SecretBackendSpec struct {
+	// Type of the backend. With `Kubernetes` random values are generated and stored in Secrets,
+	// with `ExternalSecrets` the values are read from the configured store and only synchronized
+	// to the cluster by the External Secrets Operator.
+	// +kubebuilder:validation:Enum=Kubernetes;ExternalSecrets
+	// +kubebuilder:default=Kubernetes
+	Type SecretBackendType `json:"type,omitempty"`
+	// Configuration of the ExternalSecrets backend.
+	// +optional
+	ExternalSecrets *ExternalSecretsSpec `json:"externalSecrets,omitempty"`
+}
+
+// ExternalSecretsSpec configures the store of the External Secrets Operator the credentials are sourced from.
+type ExternalSecretsSpec struct {
+	// Reference to the SecretStore or ClusterSecretStore holding the credentials, e.g. backed by Vault.
+	StoreRef SecretStoreRef `json:"storeRef"`
+	// Prefix of the remote keys. The value of each credential is read from the `<keyPrefix><namespace>/<secret name>`
+	// key, using the credential name as property.
+	// +optional
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+	// How often the credentials are synchronized from the store.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// SecretStoreRef references a SecretStore or ClusterSecretStore of the External Secrets Operator.
+type SecretStoreRef struct {
+	// Name of the store.
+	Name string `json:"name"`
+	// Kind of the store.
+	// +kubebuilder:validation:Enum=SecretStore;ClusterSecretStore
+	// +kubebuilder:default=ClusterSecretStore
+	Kind string `json:"kind,omitempty"`
+}
+
+// MaintenanceSpec defines when the operator is allowed to roll out changes.
+type MaintenanceSpec struct {
+	// Maintenance windows. A component or capability targeted by at least one window only gets
+	// changes rolled out while one of its windows is open, the others are not restricted.
+	// +listType=atomic
+	// +optional
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time during which changes are rolled out.
+type MaintenanceWindow struct {
+	// Start of the window as a cron expression with the `<minute> <hour> <day of month> <month> <day of week>`
+	// fields, e.g. `0 22 * * SAT` for every Saturday at 22:00.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// How long the window stays open after each start, at most 168h.
+	Duration metav1.Duration `json:"duration"`
+	// IANA name of the time zone the schedule is evaluated in, UTC by default.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Names of the components (e.g. `dashboard`, `kserve`) and capabilities (`monitoring`, `servicemesh`)
+	// the window applies to. The window applies to all of them when empty.
+	// +listType=set
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
 // DSCInitializationStatus defines the observed state of DSCInitialization.
 type DSCInitializationStatus struct {
 	// Phase describes the Phase of DSCInitializationStatus
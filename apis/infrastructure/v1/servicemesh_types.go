@@ -30,6 +30,35 @@ type ControlPlaneSpec struct {
 	// +kubebuilder:validation:Enum=Istio;None
 	// +kubebuilder:default=Istio
 	MetricsCollection string `json:"metricsCollection,omitempty"`
+	// IngressGateway holds configuration of the ingress gateway deployment of the Service Mesh.
+	// +optional
+	IngressGateway *IngressGatewaySpec `json:"ingressGateway,omitempty"`
+}
+
+// IngressGatewaySpec configures the ingress gateway deployment of the Service Mesh.
+type IngressGatewaySpec struct {
+	// Autoscaling configures a HorizontalPodAutoscaler for the ingress gateway deployment.
+	// If not set, the ingress gateway runs with the fixed number of replicas set by Service Mesh.
+	// +optional
+	Autoscaling *GatewayAutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// GatewayAutoscalingSpec configures horizontal autoscaling of a gateway deployment.
+// +kubebuilder:validation:XValidation:rule="self.maxReplicas >= self.minReplicas",message="maxReplicas must be greater than or equal to minReplicas"
+type GatewayAutoscalingSpec struct {
+	// MinReplicas is the lower limit for the number of replicas of the gateway. Defaults to 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas of the gateway.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the average CPU utilization, as a percentage of the requested CPU,
+	// the autoscaler aims to keep across the gateway pods. Defaults to 80.
+	// +kubebuilder:default=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TargetCPUUtilizationPercentage int32 `json:"targetCPUUtilizationPercentage,omitempty"`
 }
 
 // GatewaySpec represents the configuration of the Ingress Gateways.
@@ -64,6 +64,11 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneSpec) DeepCopyInto(out *ControlPlaneSpec) {
 	*out = *in
+	if in.IngressGateway != nil {
+		in, out := &in.IngressGateway, &out.IngressGateway
+		*out = new(IngressGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSpec.
@@ -76,6 +81,21 @@ func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAutoscalingSpec) DeepCopyInto(out *GatewayAutoscalingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAutoscalingSpec.
+func (in *GatewayAutoscalingSpec) DeepCopy() *GatewayAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
 	*out = *in
@@ -92,10 +112,30 @@ func (in *GatewaySpec) DeepCopy() *GatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGatewaySpec) DeepCopyInto(out *IngressGatewaySpec) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(GatewayAutoscalingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGatewaySpec.
+func (in *IngressGatewaySpec) DeepCopy() *IngressGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceMeshSpec) DeepCopyInto(out *ServiceMeshSpec) {
 	*out = *in
-	out.ControlPlane = in.ControlPlane
+	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
 	in.Auth.DeepCopyInto(&out.Auth)
 }
 
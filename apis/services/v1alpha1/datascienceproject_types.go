@@ -0,0 +1,128 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+)
+
+const (
+	DataScienceProjectServiceName = "datascienceproject"
+	DataScienceProjectKind        = "DataScienceProject"
+)
+
+// ProjectMemberKind is the kind of subject a project member is bound as.
+// +kubebuilder:validation:Enum=User;Group
+type ProjectMemberKind string
+
+const (
+	ProjectMemberUser  ProjectMemberKind = "User"
+	ProjectMemberGroup ProjectMemberKind = "Group"
+)
+
+// ProjectRole is the role granted to a project member, bound to the ClusterRole of the same name.
+// +kubebuilder:validation:Enum=admin;edit;view
+type ProjectRole string
+
+const (
+	ProjectRoleAdmin ProjectRole = "admin"
+	ProjectRoleEdit  ProjectRole = "edit"
+	ProjectRoleView  ProjectRole = "view"
+)
+
+// ProjectMember grants a user or a group access to the project namespace.
+type ProjectMember struct {
+	// Kind of the member, User or Group.
+	// +kubebuilder:default=User
+	Kind ProjectMemberKind `json:"kind,omitempty"`
+	// Name of the user or group.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Role of the member in the project: admin, edit or view.
+	// +kubebuilder:default=edit
+	Role ProjectRole `json:"role,omitempty"`
+}
+
+// DataScienceProjectSpec defines the desired state of DataScienceProject
+type DataScienceProjectSpec struct {
+	// DisplayName is the name of the project shown in the dashboard, defaults to the name of the namespace.
+	DisplayName string `json:"displayName,omitempty"`
+	// Description of the project shown in the dashboard.
+	Description string `json:"description,omitempty"`
+	// Members are the users and groups granted access to the project.
+	Members []ProjectMember `json:"members,omitempty"`
+	// Quota is the hard limits of the ResourceQuota of the project, e.g. requests.cpu or requests.nvidia.com/gpu.
+	// The namespace is not limited when empty.
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+	// ServiceMeshMember adds the project to the mesh of the platform, requires Service Mesh to be managed by the
+	// DSCInitialization.
+	ServiceMeshMember bool `json:"serviceMeshMember,omitempty"`
+	// DataConnections are the names of the data connections, published in the applications namespace by the
+	// platform administrators, copied to the project in addition to the default ones.
+	DataConnections []string `json:"dataConnections,omitempty"`
+}
+
+// DataScienceProjectStatus defines the observed state of DataScienceProject
+type DataScienceProjectStatus struct {
+	common.Status `json:",inline"`
+
+	// DataConnections are the names of the data connections copied to the project.
+	DataConnections []string `json:"dataConnections,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=dsp
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63",message="DataScienceProject name must be a valid namespace name"
+// +kubebuilder:validation:XValidation:rule="!self.metadata.name.startsWith('openshift-') && !self.metadata.name.startsWith('kube-') && self.metadata.name != 'default'",message="DataScienceProject name can not be a system namespace"
+// +kubebuilder:printcolumn:name="Display Name",type=string,JSONPath=`.spec.displayName`,description="Display Name"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+
+// DataScienceProject is the Schema for the datascienceprojects API, the name of the project is the name of its
+// namespace.
+type DataScienceProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataScienceProjectSpec   `json:"spec,omitempty"`
+	Status DataScienceProjectStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DataScienceProjectList contains a list of DataScienceProject
+type DataScienceProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataScienceProject `json:"items"`
+}
+
+func (p *DataScienceProject) GetDevFlags() *common.DevFlags {
+	return nil
+}
+
+func (p *DataScienceProject) GetStatus() *common.Status {
+	return &p.Status.Status
+}
+
+func init() {
+	SchemeBuilder.Register(&DataScienceProject{}, &DataScienceProjectList{})
+}
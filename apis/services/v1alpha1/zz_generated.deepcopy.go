@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -41,6 +42,118 @@ func (in *DSCMonitoring) DeepCopy() *DSCMonitoring {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceProject) DeepCopyInto(out *DataScienceProject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceProject.
+func (in *DataScienceProject) DeepCopy() *DataScienceProject {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataScienceProject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceProjectList) DeepCopyInto(out *DataScienceProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataScienceProject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceProjectList.
+func (in *DataScienceProjectList) DeepCopy() *DataScienceProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataScienceProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceProjectSpec) DeepCopyInto(out *DataScienceProjectSpec) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ProjectMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DataConnections != nil {
+		in, out := &in.DataConnections, &out.DataConnections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceProjectSpec.
+func (in *DataScienceProjectSpec) DeepCopy() *DataScienceProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceProjectStatus) DeepCopyInto(out *DataScienceProjectStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.DataConnections != nil {
+		in, out := &in.DataConnections, &out.DataConnections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceProjectStatus.
+func (in *DataScienceProjectStatus) DeepCopy() *DataScienceProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Monitoring) DeepCopyInto(out *Monitoring) {
 	*out = *in
@@ -146,3 +259,18 @@ func (in *MonitoringStatus) DeepCopy() *MonitoringStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMember.
+func (in *ProjectMember) DeepCopy() *ProjectMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMember)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -64,6 +65,10 @@ func (s *componentHandler) Init(_ cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(_ cluster.Platform) []odhtypes.ManifestInfo {
+	return []odhtypes.ManifestInfo{manifestsPath()}
+}
+
 func (s *componentHandler) UpdateDSCStatus(dsc *dscv1.DataScienceCluster, obj client.Object) error {
 	c, ok := obj.(*componentApi.CodeFlare)
 	if !ok {
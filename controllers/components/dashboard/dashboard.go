@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -48,6 +49,10 @@ func (s *componentHandler) Init(platform cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(platform cluster.Platform) []odhtypes.ManifestInfo {
+	return []odhtypes.ManifestInfo{defaultManifestInfo(platform)}
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.Dashboard{
 		TypeMeta: metav1.TypeMeta{
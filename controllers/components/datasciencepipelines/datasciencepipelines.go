@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -46,6 +47,10 @@ func (s *componentHandler) Init(_ cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(platform cluster.Platform) []types.ManifestInfo {
+	return []types.ManifestInfo{manifestPath(platform)}
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.DataSciencePipelines{
 		TypeMeta: metav1.TypeMeta{
@@ -28,9 +28,15 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/adopt"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/inventory"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podoverrides"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/restart"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/scaling"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/updatestatus"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
@@ -69,9 +75,15 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.ODH.Component(LegacyComponentName), labels.True),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, LegacyComponentName),
 		)).
+		WithAction(podoverrides.NewAction()).
+		WithAction(scaling.NewAction(scaling.WithDeployments(dspoControllerDeployment))).
+		WithAction(adopt.NewAction()).
+		WithAction(restart.NewAction()).
+		WithAction(maintenance.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
+		WithAction(inventory.NewAction()).
 		WithAction(updatestatus.NewAction()).
 		// must be the final action
 		WithAction(gc.NewAction()).
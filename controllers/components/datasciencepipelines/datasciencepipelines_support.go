@@ -20,6 +20,11 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "data-science-pipelines-operator"
+
+	// dspoControllerDeployment is the Deployment of the Data Science Pipelines Operator, scaled as configured
+	// in the component. The pipelines API servers are deployed by it for each DataSciencePipelinesApplication,
+	// and are configured there.
+	dspoControllerDeployment = "data-science-pipelines-operator-controller-manager"
 )
 
 var (
@@ -2,8 +2,17 @@ package kserve
 
 import (
 	"embed"
-	"io/fs"
 	"path"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
 )
 
 //go:embed resources
@@ -18,14 +27,48 @@ var Resources = struct {
 	InstallDir string
 	// GatewaysDir is the path to the Serving Istio gateways templates.
 	GatewaysDir string
-	// Location specifies the file system that contains the templates to be used.
-	Location fs.FS
+	// Location specifies the file system that contains the templates to be used, resolved for the
+	// flavor of the cluster.
+	Location *overlays.FS
 	// BaseDir is the path to the base of the embedded FS
 	BaseDir string
 }{
 	ServiceMeshDir: path.Join(baseDir, "servicemesh"),
 	InstallDir:     path.Join(baseDir, "serving-install"),
 	GatewaysDir:    path.Join(baseDir, "servicemesh", "routing"),
-	Location:       kserveEmbeddedFS,
+	Location:       overlays.New(kserveEmbeddedFS, baseDir, overlays.WithData(resourcesData)),
 	BaseDir:        baseDir,
 }
+
+// resourcesData returns representative data of the Serverless and Service Mesh features, so that the
+// templates can be executed on every flavor without a cluster.
+func resourcesData() (any, error) {
+	dsciSpec := dsciv1.DSCInitializationSpec{
+		ApplicationsNamespace: "opendatahub",
+		ServiceMesh: &infrav1.ServiceMeshSpec{
+			ControlPlane: infrav1.ControlPlaneSpec{
+				Name:      "data-science-smcp",
+				Namespace: "istio-system",
+			},
+		},
+	}
+
+	serving := infrav1.ServingSpec{
+		ManagementState: operatorv1.Managed,
+		Name:            "knative-serving",
+		IngressGateway: infrav1.GatewaySpec{
+			Domain: "*.apps.example.com",
+		},
+	}
+
+	entries := []feature.Action{
+		feature.Entry("Domain", provider.ValueOf("apps.example.com").Get),
+		serverless.FeatureData.IngressDomain.Define(&serving).AsAction(),
+		serverless.FeatureData.CertificateName.Define(&serving).AsAction(),
+		serverless.FeatureData.Serving.Define(&serving).AsAction(),
+		serverless.FeatureData.SecretBackend.Define(&dsciSpec).AsAction(),
+		servicemesh.FeatureData.ControlPlane.Define(&dsciSpec).AsAction(),
+	}
+
+	return feature.Data(serverless.KnativeServingNamespace, append(entries, servicemesh.FeatureData.Authorization.All(&dsciSpec)...)...)
+}
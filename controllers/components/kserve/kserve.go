@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
@@ -27,6 +28,9 @@ const (
 	kserveConfigMapName      = "inferenceservice-config"
 	kserveManifestSourcePath = "overlays/odh"
 
+	// kserveControllerDeployment is the Deployment of the KServe controller, scaled as configured in the component.
+	kserveControllerDeployment = "kserve-controller-manager"
+
 	// LegacyComponentName is the name of the component that is assigned to deployments
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
@@ -46,6 +50,10 @@ func (s *componentHandler) Init(platform cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(_ cluster.Platform) []odhtypes.ManifestInfo {
+	return []odhtypes.ManifestInfo{kserveManifestInfo(kserveManifestSourcePath)}
+}
+
 func (s *componentHandler) GetName() string {
 	return componentName
 }
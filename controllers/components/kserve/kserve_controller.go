@@ -33,9 +33,15 @@ import (
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
 	featuresv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/adopt"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/inventory"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podoverrides"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/restart"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/scaling"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/updatestatus"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates"
@@ -154,9 +160,15 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.K8SCommon.PartOf, LegacyComponentName),
 		)).
 		WithAction(customizeKserveConfigMap).
+		WithAction(podoverrides.NewAction()).
+		WithAction(scaling.NewAction(scaling.WithDeployments(kserveControllerDeployment))).
+		WithAction(adopt.NewAction()).
+		WithAction(restart.NewAction()).
+		WithAction(maintenance.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
+		WithAction(inventory.NewAction()).
 		WithAction(updatestatus.NewAction()).
 		// must be the final action
 		WithAction(gc.NewAction()).
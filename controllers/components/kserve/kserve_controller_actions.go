@@ -248,7 +248,7 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 	}
 
 	kserveDeployment := appsv1.Deployment{}
-	deployidx, err := getIndexedResource(rr.Resources, &kserveDeployment, gvk.Deployment, "kserve-controller-manager")
+	deployidx, err := getIndexedResource(rr.Resources, &kserveDeployment, gvk.Deployment, kserveControllerDeployment)
 	if err != nil {
 		return err
 	}
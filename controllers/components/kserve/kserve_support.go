@@ -83,10 +83,12 @@ func configureServerlessFeatures(dsciSpec *dsciv1.DSCInitializationSpec, kserve
 						path.Join(Resources.GatewaysDir),
 					),
 			).
+			Uncached().
 			WithData(
 				serverless.FeatureData.IngressDomain.Define(&kserve.Spec.Serving).AsAction(),
 				serverless.FeatureData.CertificateName.Define(&kserve.Spec.Serving).AsAction(),
 				serverless.FeatureData.Serving.Define(&kserve.Spec.Serving).AsAction(),
+				serverless.FeatureData.SecretBackend.Define(dsciSpec).AsAction(),
 				servicemesh.FeatureData.ControlPlane.Define(dsciSpec).AsAction(),
 			).
 			WithResources(serverless.ServingCertificateResource).
@@ -118,6 +120,7 @@ func defineServiceMeshFeatures(ctx context.Context, cli client.Client, dscispec
 						),
 				).
 				Managed().
+				Uncached().
 				WithData(
 					feature.Entry("Domain", cluster.GetDomain),
 					servicemesh.FeatureData.ControlPlane.Define(dscispec).AsAction(),
@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -71,6 +72,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.Pla
 				DevFlagsSpec:    dsc.Spec.Components.Kserve.DevFlagsSpec,
 				NIM:             dsc.Spec.Components.Kserve.NIM,
 			},
+			AcceleratorDefaultsSpec: dsc.Spec.AcceleratorDefaultsSpec,
 		},
 	}
 }
@@ -85,6 +87,10 @@ func (s *componentHandler) Init(_ cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(_ cluster.Platform) []types.ManifestInfo {
+	return []types.ManifestInfo{manifestsPath()}
+}
+
 func (s *componentHandler) UpdateDSCStatus(dsc *dscv1.DataScienceCluster, obj client.Object) error {
 	c, ok := obj.(*componentApi.ModelController)
 	if !ok {
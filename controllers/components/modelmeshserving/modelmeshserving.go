@@ -17,6 +17,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -47,6 +48,10 @@ func (s *componentHandler) Init(_ cluster.Platform) error {
 	return nil
 }
 
+func (s *componentHandler) Manifests(_ cluster.Platform) []odhtypes.ManifestInfo {
+	return []odhtypes.ManifestInfo{manifestsPath()}
+}
+
 // for DSC to get compoment ModelMeshServing's CR.
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.ModelMeshServing{
@@ -59,7 +59,7 @@ func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	}
 
 	rr.Templates = []odhtypes.TemplateInfo{{
-		FS:   resourcesFS,
+		FS:   Resources,
 		Path: ServiceMeshMemberTemplate,
 	}}
 
@@ -5,11 +5,16 @@ import (
 	"path"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
 )
 
 const (
@@ -41,7 +46,38 @@ var (
 )
 
 //go:embed resources
-var resourcesFS embed.FS
+var embeddedFS embed.FS
+
+// Resources specifies the file system that contains the templates to be used, resolved for the
+// flavor of the cluster.
+var Resources = overlays.New(embeddedFS, "resources", overlays.WithData(resourcesData))
+
+// resourcesData returns representative data of the rendering of the templates, so that they can be
+// executed on every flavor without a cluster.
+func resourcesData() (any, error) {
+	mr := componentApi.ModelRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.ModelRegistryInstanceName},
+	}
+	mr.Spec.RegistriesNamespace = DefaultModelRegistriesNamespace
+
+	dsci := dsciv1.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec: dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ControlPlane: infrav1.ControlPlaneSpec{
+					Name:      "data-science-smcp",
+					Namespace: "istio-system",
+				},
+			},
+		},
+	}
+
+	return map[string]any{
+		template.ComponentKey: &mr,
+		template.DSCIKey:      &dsci,
+	}, nil
+}
 
 func baseManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 	return odhtypes.ManifestInfo{
@@ -28,6 +28,10 @@ const (
 
 	nbcServiceAccountName = "notebook-controller-service-account"
 
+	// Deployments of the notebook controllers, scaled as configured in the component.
+	notebookControllerDeployment   = "odh-notebook-controller-manager"
+	kfNotebookControllerDeployment = "notebook-controller-deployment"
+
 	// LegacyComponentName is the name of the component that is assigned to deployments
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
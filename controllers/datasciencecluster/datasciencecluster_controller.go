@@ -49,6 +49,8 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/dependent"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 // DataScienceClusterReconciler reconciles a DataScienceCluster object.
@@ -234,6 +236,11 @@ func (r *DataScienceClusterReconciler) reconcileComponent(
 	ms := component.GetManagementState(instance)
 	componentCR := component.NewCRObject(instance)
 
+	// a restart requested for the whole platform is forwarded to all the components
+	if token := resources.GetAnnotation(instance, annotations.RestartRequested); token != "" {
+		resources.SetAnnotation(componentCR, annotations.RestartRequested, token)
+	}
+
 	switch ms {
 	case operatorv1.Managed:
 		err := ctrl.SetControllerReference(instance, componentCR, r.Scheme)
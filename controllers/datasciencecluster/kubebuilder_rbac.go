@@ -48,6 +48,10 @@ package datasciencecluster
 
 // +kubebuilder:rbac:groups="integreatly.org",resources=rhmis,verbs=list;watch;patch;delete;get
 
+// APIs removed from the platform, cleaned up on upgrade
+// +kubebuilder:rbac:groups="kfdef.apps.kubeflow.org",resources=kfdefs,verbs=list;patch;delete;get
+// +kubebuilder:rbac:groups="workload.codeflare.dev",resources=appwrappers;schedulingspecs,verbs=list;patch;delete;get
+
 // +kubebuilder:rbac:groups="extensions",resources=replicasets,verbs=*
 // +kubebuilder:rbac:groups="extensions",resources=ingresses,verbs=list;watch;patch;delete;get
 
@@ -2,10 +2,13 @@ package dscinitialization
 
 import (
 	"errors"
+	"time"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
@@ -40,14 +43,30 @@ func createCapabilityReporter(cli client.Client, object *dsciv1.DSCInitializatio
 				if err != nil {
 					actualCondition.Status = corev1.ConditionFalse
 					actualCondition.Message = err.Error()
-					actualCondition.Reason = status.CapabilityFailed
-					var missingOperatorErr *feature.MissingOperatorError
-					if errors.As(err, &missingOperatorErr) {
-						actualCondition.Reason = status.MissingOperatorReason
-					}
+					actualCondition.Reason = feature.ConditionReason(err, status.CapabilityFailed)
 				}
 				conditionsv1.SetStatusCondition(&saved.Status.Conditions, *actualCondition)
 			}
 		},
 	)
 }
+
+// missingOperatorRequeuePeriod is the time after which the capabilities are applied again when a
+// prerequisite operator is missing, as the installation of operators does not trigger a reconciliation.
+const missingOperatorRequeuePeriod = 5 * time.Minute
+
+// capabilityResult maps the error returned by a capability to the result of the reconciliation.
+// Errors which can not be solved by retrying are terminal, the instance is reconciled again when
+// it changes.
+func capabilityResult(err error) (ctrl.Result, error) {
+	switch {
+	case err == nil:
+		return ctrl.Result{}, nil
+	case errors.Is(err, feature.ErrMissingPrerequisiteOperator):
+		return ctrl.Result{RequeueAfter: missingOperatorRequeuePeriod}, nil
+	case errors.Is(err, feature.ErrTemplateRender):
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	default:
+		return ctrl.Result{}, err
+	}
+}
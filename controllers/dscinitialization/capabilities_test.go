@@ -0,0 +1,33 @@
+package dscinitialization
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCapabilityResult(t *testing.T) {
+	g := NewWithT(t)
+
+	result, err := capabilityResult(nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result).Should(Equal(ctrl.Result{}))
+
+	result, err = capabilityResult(fmt.Errorf("failed applying: %w", feature.NewMissingOperatorError("servicemeshoperator", nil)))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).Should(Equal(missingOperatorRequeuePeriod))
+
+	_, err = capabilityResult(fmt.Errorf("%w: failed to execute template", feature.ErrTemplateRender))
+	g.Expect(errors.Is(err, reconcile.TerminalError(nil))).Should(BeTrue())
+
+	_, err = capabilityResult(fmt.Errorf("%w: failed to get smcp", feature.ErrRBACDenied))
+	g.Expect(err).Should(MatchError(feature.ErrRBACDenied))
+	g.Expect(errors.Is(err, reconcile.TerminalError(nil))).Should(BeFalse())
+}
@@ -21,9 +21,12 @@ import (
 	"context"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"time"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -68,6 +71,8 @@ type DSCInitializationReconciler struct {
 	Scheme                *runtime.Scheme
 	Recorder              record.EventRecorder
 	ApplicationsNamespace string
+
+	endpoints *endpointsValidator
 }
 
 // Reconcile contains controller logic specific to DSCInitialization instance updates.
@@ -87,6 +92,10 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
+	if r.endpoints != nil {
+		r.endpoints.Prune(instances.Items)
+	}
+
 	var instance *dsciv1.DSCInitialization
 	switch { // only handle number as 0 or 1, others won't be existed since webhook block creation
 	case len(instances.Items) == 0:
@@ -171,9 +180,41 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
+	// Validate the external endpoints in the background, results are reported as conditions
+	var revalidateAfter time.Duration
+	if r.endpoints != nil {
+		revalidateAfter = r.endpoints.Validate(ctx, instance)
+	}
+
+	// Wait for the platform namespaces deleted out-of-band to be terminated before rebuilding them
+	authorinoInstalled, err := cluster.SubscriptionExists(ctx, r.Client, "authorino-operator")
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	namespaces, err := observeNamespaces(ctx, r.Client, platformNamespaces(instance, authorinoInstalled))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	recovering := namespaces.Recovering(instance)
+	if recovering {
+		condition := namespaces.Condition()
+		if _, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, condition)
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if len(namespaces.Terminating) > 0 {
+			log.Info("Waiting for platform namespaces to be terminated", "namespaces", namespaces.Terminating)
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "PlatformNamespaceTerminating", "%s", condition.Message)
+
+			return ctrl.Result{RequeueAfter: namespaceTerminationRecheckPeriod}, nil
+		}
+	}
+
 	// Check namespace is not exist, then create
 	namespace := instance.Spec.ApplicationsNamespace
-	err := r.createOdhNamespace(ctx, instance, namespace, platform)
+	err = r.createOdhNamespace(ctx, instance, namespace, platform)
 	if err != nil {
 		// no need to log error as it was already logged in createOdhNamespace
 		return reconcile.Result{}, err
@@ -275,14 +316,29 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			}
 		}
 
-		// Apply Service Mesh configurations
-		if errServiceMesh := r.configureServiceMesh(ctx, instance); errServiceMesh != nil {
-			return reconcile.Result{}, errServiceMesh
+		// Apply Service Mesh configurations, unless deferred to the next maintenance window. The features
+		// of re-created namespaces are always rebuilt.
+		var requeueAfter time.Duration
+		if !recovering {
+			requeueAfter = serviceMeshDeferral(ctx, instance, time.Now())
+		}
+		if requeueAfter > 0 {
+			log.Info("Service Mesh changes deferred to the next maintenance window", "requeueAfter", requeueAfter)
+		} else if errServiceMesh := r.configureServiceMesh(ctx, instance); errServiceMesh != nil {
+			return capabilityResult(errServiceMesh)
 		}
 
 		// Finish reconciling
 		_, err = status.UpdateWithRetry[*dsciv1.DSCInitialization](ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
 			status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompleted, status.ReconcileCompletedMessage)
+			if recovering {
+				conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+					Type:    status.ConditionPlatformNamespaces,
+					Status:  corev1.ConditionTrue,
+					Reason:  status.NamespacesAvailableReason,
+					Message: "Platform namespaces re-created",
+				})
+			}
 			saved.Status.Phase = status.PhaseReady
 		})
 		if err != nil {
@@ -290,12 +346,21 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "Failed to update DSCInitialization status")
 		}
 
-		return ctrl.Result{}, nil
+		if requeueAfter == 0 || (revalidateAfter > 0 && revalidateAfter < requeueAfter) {
+			requeueAfter = revalidateAfter
+		}
+
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	r.endpoints = newEndpointsValidator(r.Client, mgr.GetAPIReader())
+	if err := mgr.Add(r.endpoints); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		// add predicates prevents meaningless reconciliations from being triggered
 		// not use WithEventFilter() because it conflict with secret and configmap predicate
@@ -339,6 +404,11 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns(
 			&routev1.Route{},
 			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.watchPlatformNamespaces),
+			builder.WithPredicates(namespaceDeletionPredicate),
+		).
 		Watches(
 			&dscv1.DataScienceCluster{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
@@ -383,6 +453,21 @@ var DSCDeletionPredicate = predicate.Funcs{
 	},
 }
 
+var namespaceDeletionPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetDeletionTimestamp() == nil && e.ObjectNew.GetDeletionTimestamp() != nil
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return true
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
 var dsciPredicateStateChangeTrustedCA = predicate.Funcs{
 	UpdateFunc: func(e event.UpdateEvent) bool {
 		oldDSCI, _ := e.ObjectOld.(*dsciv1.DSCInitialization)
@@ -435,3 +520,22 @@ func (r *DSCInitializationReconciler) watchDSCResource(ctx context.Context) []re
 	}
 	return nil
 }
+
+func (r *DSCInitializationReconciler) watchPlatformNamespaces(ctx context.Context, a client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+	instances := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, instances); err != nil {
+		log.Error(err, "Failed to get DSCInitializationList")
+		return nil
+	}
+	for i := range instances.Items {
+		// the authorization namespace is included whether Authorino is installed or not, reconciling once
+		// more is harmless
+		if slices.Contains(platformNamespaces(&instances.Items[i], true), a.GetName()) {
+			log.Info("Found platform namespace deleted, start reconcile", "namespace", a.GetName())
+
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: instances.Items[i].Name}}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,763 @@
+package dscinitialization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// endpointsValidationTimeout bounds the time spent validating all the endpoints of an instance.
+	endpointsValidationTimeout = 30 * time.Second
+	// endpointsRevalidationPeriod is the minimum time after which unchanged endpoints are validated again,
+	// since the state of the external services can change independently of the DSCInitialization.
+	endpointsRevalidationPeriod = 10 * time.Minute
+)
+
+// endpointCheck validates an external dependency configured in the DSCInitialization. A nil condition
+// means the endpoint is not configured, and any previous condition about it is removed.
+type endpointCheck struct {
+	conditionType conditionsv1.ConditionType
+	validate      func(ctx context.Context, cli client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition
+}
+
+var endpointChecks = []endpointCheck{
+	{conditionType: status.EndpointServiceMeshControlPlane, validate: validateServiceMeshControlPlane},
+	{conditionType: status.EndpointCustomCABundle, validate: validateCustomCABundle},
+	{conditionType: status.EndpointSecretStore, validate: validateSecretStore},
+	{conditionType: status.EndpointOIDCIssuer, validate: validateOIDCIssuer},
+	{conditionType: status.EndpointImageMirrors, validate: validateImageMirrors},
+}
+
+// mirrorSet is a kind of the cluster configuration listing the mirrors the images are pulled from.
+type mirrorSet struct {
+	kind  schema.GroupVersionKind
+	field string
+}
+
+var mirrorSets = []mirrorSet{
+	{kind: gvk.ImageDigestMirrorSet, field: "imageDigestMirrors"},
+	{kind: gvk.ImageTagMirrorSet, field: "imageTagMirrors"},
+}
+
+type endpointsValidation struct {
+	hash string
+	time time.Time
+}
+
+// endpointsValidator validates the external endpoints configured in the DSCInitialization in the
+// background, so that slow or unreachable services never block the reconciliation. The validations are
+// queued by the reconciliation and processed by the validator, which runs as a Runnable of the manager.
+// The results are reported as conditions of the instance once all the checks are completed.
+type endpointsValidator struct {
+	client client.Client
+	reader client.Reader
+	queue  workqueue.Interface
+
+	mu          sync.Mutex
+	validations map[string]endpointsValidation
+}
+
+// newEndpointsValidator creates a validator looking up the endpoints with reader, which should not be
+// cached to avoid watching resources the operator does not own, and reporting the results with cli.
+func newEndpointsValidator(cli client.Client, reader client.Reader) *endpointsValidator {
+	return &endpointsValidator{
+		client:      cli,
+		reader:      reader,
+		queue:       workqueue.New(),
+		validations: map[string]endpointsValidation{},
+	}
+}
+
+// Start processes the queued validations until the context is done.
+func (v *endpointsValidator) Start(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		v.queue.ShutDown()
+	}()
+
+	for v.process(ctx) {
+	}
+
+	return nil
+}
+
+func (v *endpointsValidator) process(ctx context.Context) bool {
+	item, shutdown := v.queue.Get()
+	if shutdown {
+		return false
+	}
+
+	defer v.queue.Done(item)
+
+	name, ok := item.(string)
+	if !ok {
+		return true
+	}
+
+	vctx, cancel := context.WithTimeout(ctx, endpointsValidationTimeout)
+	defer cancel()
+
+	v.run(vctx, name)
+
+	return true
+}
+
+// Validate queues the validation of the endpoints of the instance, unless they have already been validated
+// recently with the same configuration. It returns the time after which the endpoints are due for validation
+// again, for the reconciliation to be requeued.
+func (v *endpointsValidator) Validate(ctx context.Context, instance *dsciv1.DSCInitialization) time.Duration {
+	hash, err := endpointsHash(instance)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to compute endpoints configuration hash")
+		return 0
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	last, found := v.validations[instance.Name]
+	if found && last.hash == hash {
+		if elapsed := time.Since(last.time); elapsed < endpointsRevalidationPeriod {
+			return endpointsRevalidationPeriod - elapsed
+		}
+	}
+
+	v.validations[instance.Name] = endpointsValidation{hash: hash, time: time.Now()}
+	v.queue.Add(instance.Name)
+
+	return endpointsRevalidationPeriod
+}
+
+// Prune forgets the validations of the instances which no longer exist.
+func (v *endpointsValidator) Prune(instances []dsciv1.DSCInitialization) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for name := range v.validations {
+		if !slices.ContainsFunc(instances, func(i dsciv1.DSCInitialization) bool { return i.Name == name }) {
+			delete(v.validations, name)
+		}
+	}
+}
+
+func (v *endpointsValidator) forget(name string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	delete(v.validations, name)
+}
+
+func (v *endpointsValidator) run(ctx context.Context, name string) {
+	log := logf.FromContext(ctx).WithName("endpoints").WithValues("DSCInitialization", name)
+
+	instance := dsciv1.DSCInitialization{}
+	if err := v.client.Get(ctx, client.ObjectKey{Name: name}, &instance); err != nil {
+		if !k8serr.IsNotFound(err) {
+			log.Error(err, "failed to get instance to validate")
+		}
+
+		v.forget(name)
+
+		return
+	}
+
+	results := make(map[conditionsv1.ConditionType]*conditionsv1.Condition, len(endpointChecks))
+	for _, c := range endpointChecks {
+		results[c.conditionType] = c.validate(ctx, v.reader, &instance)
+	}
+
+	_, err := status.UpdateWithRetry(ctx, v.client, &instance, func(saved *dsciv1.DSCInitialization) {
+		for t, c := range results {
+			if c == nil {
+				conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, t)
+				continue
+			}
+
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, *c)
+		}
+	})
+	if err != nil {
+		log.Error(err, "failed to report endpoints validation results")
+
+		// make sure the validation is attempted again on the next reconciliation
+		v.forget(name)
+	}
+}
+
+func endpointsHash(instance *dsciv1.DSCInitialization) (string, error) {
+	data, err := json.Marshal([]any{
+		instance.Spec.ApplicationsNamespace,
+		instance.Spec.ServiceMesh,
+		instance.Spec.TrustedCABundle,
+		instance.Spec.SecretBackend,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func endpointCondition(t conditionsv1.ConditionType, reason string, format string, args ...any) *conditionsv1.Condition {
+	c := conditionsv1.Condition{
+		Type:    t,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+
+	if reason == status.EndpointValidReason {
+		c.Status = corev1.ConditionTrue
+	}
+
+	return &c
+}
+
+// readyCondition returns the status and message of the Ready condition of obj, if any.
+func readyCondition(obj *unstructured.Unstructured) (string, string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for i := range conditions {
+		c, ok := conditions[i].(map[string]any)
+		if !ok || c["type"] != "Ready" {
+			continue
+		}
+
+		s, _ := c["status"].(string)
+		m, _ := c["message"].(string)
+
+		return s, m, true
+	}
+
+	return "", "", false
+}
+
+func validateServiceMeshControlPlane(ctx context.Context, cli client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition {
+	sm := instance.Spec.ServiceMesh
+	if sm == nil || sm.ManagementState == operatorv1.Removed || sm.ManagementState == "" {
+		return nil
+	}
+
+	t := status.EndpointServiceMeshControlPlane
+	name := sm.ControlPlane.Namespace + "/" + sm.ControlPlane.Name
+
+	smcp := resources.GvkToUnstructured(gvk.ServiceMeshControlPlane)
+	err := cli.Get(ctx, client.ObjectKey{Namespace: sm.ControlPlane.Namespace, Name: sm.ControlPlane.Name}, smcp)
+
+	switch {
+	case meta.IsNoMatchError(err):
+		return endpointCondition(t, status.EndpointUnreachableReason,
+			"ServiceMeshControlPlane API not available, the Service Mesh operator is not installed")
+	case k8serr.IsNotFound(err) && sm.ManagementState == operatorv1.Managed:
+		return endpointCondition(t, status.EndpointValidReason, "ServiceMeshControlPlane %s will be created", name)
+	case k8serr.IsNotFound(err):
+		return endpointCondition(t, status.EndpointUnreachableReason, "ServiceMeshControlPlane %s not found", name)
+	case err != nil:
+		return endpointCondition(t, status.EndpointUnreachableReason, "failed to lookup ServiceMeshControlPlane %s: %v", name, err)
+	}
+
+	if s, m, found := readyCondition(smcp); found && s != string(corev1.ConditionTrue) {
+		return endpointCondition(t, status.EndpointUnreachableReason, "ServiceMeshControlPlane %s is not ready: %s", name, m)
+	}
+
+	return endpointCondition(t, status.EndpointValidReason, "ServiceMeshControlPlane %s is available", name)
+}
+
+func validateCustomCABundle(_ context.Context, _ client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition {
+	ca := instance.Spec.TrustedCABundle
+	if ca == nil || ca.ManagementState != operatorv1.Managed || ca.CustomCABundle == "" {
+		return nil
+	}
+
+	t := status.EndpointCustomCABundle
+	now := time.Now()
+	count := 0
+
+	rest := []byte(ca.CustomCABundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return endpointCondition(t, status.EndpointTLSInvalidReason, "failed to parse certificate %d of the custom CA bundle: %v", count+1, err)
+		}
+
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return endpointCondition(t, status.EndpointTLSInvalidReason, "certificate %q of the custom CA bundle is only valid from %s to %s",
+				cert.Subject.String(), cert.NotBefore.Format(time.RFC3339), cert.NotAfter.Format(time.RFC3339))
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		return endpointCondition(t, status.EndpointTLSInvalidReason, "no PEM encoded certificate found in the custom CA bundle")
+	}
+
+	return endpointCondition(t, status.EndpointValidReason, "%d certificates of the custom CA bundle are valid", count)
+}
+
+func validateSecretStore(ctx context.Context, cli client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition {
+	sb := instance.Spec.SecretBackend
+	if sb == nil || sb.Type != dsciv1.SecretBackendExternalSecrets || sb.ExternalSecrets == nil {
+		return nil
+	}
+
+	t := status.EndpointSecretStore
+	ref := sb.ExternalSecrets.StoreRef
+
+	kind := gvk.ClusterSecretStore
+	key := client.ObjectKey{Name: ref.Name}
+	if ref.Kind == gvk.SecretStore.Kind {
+		kind = gvk.SecretStore
+		key.Namespace = instance.Spec.ApplicationsNamespace
+	}
+
+	store := resources.GvkToUnstructured(kind)
+	err := cli.Get(ctx, key, store)
+
+	switch {
+	case meta.IsNoMatchError(err):
+		return endpointCondition(t, status.EndpointUnreachableReason, "%s API not available, the External Secrets Operator is not installed", kind.Kind)
+	case k8serr.IsNotFound(err):
+		return endpointCondition(t, status.EndpointUnreachableReason, "%s %s not found", kind.Kind, ref.Name)
+	case err != nil:
+		return endpointCondition(t, status.EndpointUnreachableReason, "failed to lookup %s %s: %v", kind.Kind, ref.Name, err)
+	}
+
+	// the server of the provider is probed directly, as the status of the store is only refreshed
+	// periodically by the External Secrets Operator
+	if server, caBundle := storeServer(store); server != "" {
+		tlsConfig := endpointTLSConfig(instance, caBundle)
+
+		if reason, err := probeServer(ctx, server, tlsConfig); err != nil {
+			return endpointCondition(t, reason, "%s %s: %v", kind.Kind, ref.Name, err)
+		}
+
+		if reason, err := probeVaultToken(ctx, cli, store, server, tlsConfig); err != nil {
+			return endpointCondition(t, reason, "%s %s: %v", kind.Kind, ref.Name, err)
+		}
+	}
+
+	// the store is validated by the External Secrets Operator against the provider, which includes
+	// authenticating with the configured credentials
+	s, m, found := readyCondition(store)
+	switch {
+	case !found:
+		return endpointCondition(t, status.EndpointUnreachableReason, "%s %s has not been validated yet", kind.Kind, ref.Name)
+	case s != string(corev1.ConditionTrue):
+		return endpointCondition(t, status.EndpointAuthRejectedReason, "%s %s is not ready: %s", kind.Kind, ref.Name, m)
+	}
+
+	return endpointCondition(t, status.EndpointValidReason, "%s %s is ready", kind.Kind, ref.Name)
+}
+
+// validateOIDCIssuer validates the issuers of the cluster authentication, i.e. the external OIDC providers
+// and the service account issuer, which are part of the cluster configuration rather than of the
+// DSCInitialization. Changes of the cluster configuration are picked up by the periodic revalidation.
+func validateOIDCIssuer(ctx context.Context, cli client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition {
+	t := status.EndpointOIDCIssuer
+
+	auth := resources.GvkToUnstructured(gvk.OpenshiftAuthentication)
+	err := cli.Get(ctx, client.ObjectKey{Name: cluster.ClusterAuthenticationObj}, auth)
+
+	switch {
+	case meta.IsNoMatchError(err), k8serr.IsNotFound(err):
+		return nil
+	case err != nil:
+		return endpointCondition(t, status.EndpointUnreachableReason, "failed to lookup Authentication %s: %v", cluster.ClusterAuthenticationObj, err)
+	}
+
+	issuers := make(map[string][]byte)
+
+	if authType, _, _ := unstructured.NestedString(auth.Object, "spec", "type"); authType == "OIDC" {
+		providers, _, _ := unstructured.NestedSlice(auth.Object, "spec", "oidcProviders")
+		for _, p := range providers {
+			provider, ok := p.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			issuer, _, _ := unstructured.NestedString(provider, "issuer", "issuerURL")
+			if issuer == "" {
+				continue
+			}
+
+			name, _, _ := unstructured.NestedString(provider, "issuer", "certificateAuthority", "name")
+			issuers[issuer] = openshiftConfigCA(ctx, cli, name, "ca-bundle.crt")
+		}
+	}
+
+	if issuer, _, _ := unstructured.NestedString(auth.Object, "spec", "serviceAccountIssuer"); issuer != "" {
+		issuers[issuer] = nil
+	}
+
+	if len(issuers) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(issuers))
+	for issuer := range issuers {
+		names = append(names, issuer)
+	}
+
+	slices.Sort(names)
+
+	for _, issuer := range names {
+		if reason, err := probeOIDCIssuer(ctx, issuer, endpointTLSConfig(instance, issuers[issuer])); err != nil {
+			return endpointCondition(t, reason, "OIDC issuer %s: %v", issuer, err)
+		}
+	}
+
+	return endpointCondition(t, status.EndpointValidReason, "%d OIDC issuers are available", len(issuers))
+}
+
+// validateImageMirrors validates the registries of the image mirrors configured in the cluster, which
+// are part of the cluster configuration rather than of the DSCInitialization. Changes of the cluster
+// configuration are picked up by the periodic revalidation.
+func validateImageMirrors(ctx context.Context, cli client.Reader, instance *dsciv1.DSCInitialization) *conditionsv1.Condition {
+	t := status.EndpointImageMirrors
+	registries := make([]string, 0)
+
+	for _, ms := range mirrorSets {
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(ms.kind.GroupVersion().WithKind(ms.kind.Kind + "List"))
+
+		err := cli.List(ctx, &items)
+		switch {
+		case meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			return endpointCondition(t, status.EndpointUnreachableReason, "failed to list %s: %v", ms.kind.Kind, err)
+		}
+
+		for _, item := range items.Items {
+			mirrors, _, _ := unstructured.NestedSlice(item.Object, "spec", ms.field)
+			for _, m := range mirrors {
+				mirror, ok := m.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				names, _, _ := unstructured.NestedStringSlice(mirror, "mirrors")
+				for _, n := range names {
+					registry, _, _ := strings.Cut(n, "/")
+					if !slices.Contains(registries, registry) {
+						registries = append(registries, registry)
+					}
+				}
+			}
+		}
+	}
+
+	if len(registries) == 0 {
+		return nil
+	}
+
+	// the additional CAs the cluster trusts for the registries are keyed by registry, with the port
+	// separated by two dots
+	image := resources.GvkToUnstructured(gvk.OpenshiftImage)
+	if err := cli.Get(ctx, client.ObjectKey{Name: cluster.ClusterImageObj}, image); err != nil && !meta.IsNoMatchError(err) && !k8serr.IsNotFound(err) {
+		return endpointCondition(t, status.EndpointUnreachableReason, "failed to lookup Image %s: %v", cluster.ClusterImageObj, err)
+	}
+
+	caName, _, _ := unstructured.NestedString(image.Object, "spec", "additionalTrustedCA", "name")
+
+	slices.Sort(registries)
+	for _, registry := range registries {
+		caBundle := openshiftConfigCA(ctx, cli, caName, strings.ReplaceAll(registry, ":", ".."))
+
+		if reason, err := probeRegistry(ctx, registry, endpointTLSConfig(instance, caBundle)); err != nil {
+			return endpointCondition(t, reason, "image mirror %s: %v", registry, err)
+		}
+	}
+
+	return endpointCondition(t, status.EndpointValidReason, "%d image mirror registries are reachable", len(registries))
+}
+
+// openshiftConfigCA returns the CA bundle stored under the given key of the ConfigMap of the openshift-config
+// namespace, if any.
+func openshiftConfigCA(ctx context.Context, cli client.Reader, name string, key string) []byte {
+	if name == "" {
+		return nil
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: cluster.OpenshiftConfigNamespace, Name: name}, &cm); err != nil {
+		return nil
+	}
+
+	return []byte(cm.Data[key])
+}
+
+// storeServer returns the address of the server of the provider of the store, and the CA bundle it is
+// verified with, if the provider has one, e.g. Vault.
+func storeServer(store *unstructured.Unstructured) (string, []byte) {
+	providers, _, _ := unstructured.NestedMap(store.Object, "spec", "provider")
+
+	for _, p := range providers {
+		provider, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		caBundle, _ := provider["caBundle"].(string)
+		ca, _ := base64.StdEncoding.DecodeString(caBundle)
+
+		for _, field := range []string{"server", "vaultUrl", "url"} {
+			if server, ok := provider[field].(string); ok && server != "" {
+				return server, ca
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// endpointTLSConfig trusts the system roots, the custom CA bundle of the instance and the given CA bundle.
+func endpointTLSConfig(instance *dsciv1.DSCInitialization, caBundle []byte) *tls.Config {
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+
+	if ca := instance.Spec.TrustedCABundle; ca != nil && ca.ManagementState == operatorv1.Managed {
+		roots.AppendCertsFromPEM([]byte(ca.CustomCABundle))
+	}
+
+	roots.AppendCertsFromPEM(caBundle)
+
+	return &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+}
+
+// probeServer dials the server, completing the TLS handshake for https addresses. It returns the reason
+// of the failure, if any.
+func probeServer(ctx context.Context, server string, tlsConfig *tls.Config) (string, error) {
+	u, err := url.Parse(server)
+	if err != nil || u.Hostname() == "" {
+		return status.EndpointUnreachableReason, fmt.Errorf("invalid server address %q", server)
+	}
+
+	port := u.Port()
+	switch {
+	case port != "":
+	case u.Scheme == "http":
+		port = "80"
+	default:
+		port = "443"
+	}
+
+	addr := net.JoinHostPort(u.Hostname(), port)
+
+	var conn net.Conn
+	if u.Scheme == "http" {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	}
+
+	var verr *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &verr):
+		return status.EndpointTLSInvalidReason, fmt.Errorf("certificate of server %s is not trusted: %w", server, err)
+	case err != nil:
+		return status.EndpointUnreachableReason, fmt.Errorf("server %s is not reachable: %w", server, err)
+	}
+
+	_ = conn.Close()
+
+	return "", nil
+}
+
+// probeVaultToken authenticates with the token the store uses to access Vault, if any. The other
+// authentication methods are only validated by the External Secrets Operator.
+func probeVaultToken(ctx context.Context, cli client.Reader, store *unstructured.Unstructured, server string, tlsConfig *tls.Config) (string, error) {
+	ref, found, _ := unstructured.NestedStringMap(store.Object, "spec", "provider", "vault", "auth", "tokenSecretRef")
+	if !found {
+		return "", nil
+	}
+
+	if err := requireHTTPS(server); err != nil {
+		return status.EndpointTLSInvalidReason, fmt.Errorf("refusing to send the Vault token: %w", err)
+	}
+
+	// the secrets referenced by a namespaced store are always looked up in its own namespace, as the
+	// External Secrets Operator does, so that it cannot be used to read the secrets of other namespaces
+	key := client.ObjectKey{Namespace: ref["namespace"], Name: ref["name"]}
+	if store.GetNamespace() != "" {
+		key.Namespace = store.GetNamespace()
+	}
+
+	secret := corev1.Secret{}
+	if err := cli.Get(ctx, key, &secret); err != nil {
+		return status.EndpointAuthRejectedReason, fmt.Errorf("failed to get Vault token secret %s: %w", key, err)
+	}
+
+	tokenKey := ref["key"]
+	if tokenKey == "" {
+		tokenKey = "token"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+"/v1/auth/token/lookup-self", nil)
+	if err != nil {
+		return status.EndpointUnreachableReason, err
+	}
+
+	req.Header.Set("X-Vault-Token", string(secret.Data[tokenKey]))
+	if ns, _, _ := unstructured.NestedString(store.Object, "spec", "provider", "vault", "namespace"); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+
+	resp, reason, err := sendRequest(req, tlsConfig)
+	if err != nil {
+		return reason, err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return "", nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return status.EndpointAuthRejectedReason, fmt.Errorf("token of secret %s rejected by server %s: %s", key, server, resp.Status)
+	default:
+		return status.EndpointUnreachableReason, fmt.Errorf("unexpected response of server %s to token lookup: %s", server, resp.Status)
+	}
+}
+
+// requireHTTPS returns an error if the server is not addressed with https, credentials must never be
+// sent in clear text.
+func requireHTTPS(server string) error {
+	u, err := url.Parse(server)
+	if err != nil {
+		return fmt.Errorf("invalid server address %q", server)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("server %s is not addressed with https", server)
+	}
+
+	return nil
+}
+
+// sendRequest sends the request without following redirects, so that the credentials it carries are only
+// sent to the requested server. It returns the reason of the failure, if any.
+func sendRequest(req *http.Request, tlsConfig *tls.Config) (*http.Response, string, error) {
+	hc := http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := hc.Do(req)
+
+	var verr *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &verr):
+		return nil, status.EndpointTLSInvalidReason, fmt.Errorf("certificate of server %s is not trusted: %w", req.URL.Host, err)
+	case err != nil:
+		return nil, status.EndpointUnreachableReason, fmt.Errorf("server %s is not reachable: %w", req.URL.Host, err)
+	}
+
+	return resp, "", nil
+}
+
+// probeOIDCIssuer fetches the discovery document of the issuer, which must be served with https and
+// describe the issuer itself.
+func probeOIDCIssuer(ctx context.Context, issuer string, tlsConfig *tls.Config) (string, error) {
+	if err := requireHTTPS(issuer); err != nil {
+		return status.EndpointTLSInvalidReason, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return status.EndpointUnreachableReason, err
+	}
+
+	resp, reason, err := sendRequest(req, tlsConfig)
+	if err != nil {
+		return reason, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return status.EndpointUnreachableReason, fmt.Errorf("unexpected response of server %s to discovery: %s", issuer, resp.Status)
+	}
+
+	discovery := struct {
+		Issuer string `json:"issuer"`
+	}{}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&discovery); err != nil {
+		return status.EndpointUnreachableReason, fmt.Errorf("invalid discovery document of server %s: %w", issuer, err)
+	}
+
+	if discovery.Issuer != issuer {
+		return status.EndpointUnreachableReason, fmt.Errorf("discovery document of server %s describes issuer %q", issuer, discovery.Issuer)
+	}
+
+	return "", nil
+}
+
+// probeRegistry checks the registry serves the registry API with https. The registry is not authenticated
+// with, as the pull secret of the cluster is used by the nodes only, so an unauthorized response is accepted.
+func probeRegistry(ctx context.Context, registry string, tlsConfig *tls.Config) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+registry+"/v2/", nil)
+	if err != nil {
+		return status.EndpointUnreachableReason, err
+	}
+
+	resp, reason, err := sendRequest(req, tlsConfig)
+	if err != nil {
+		return reason, err
+	}
+
+	_ = resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusUnauthorized:
+		return "", nil
+	default:
+		return status.EndpointUnreachableReason, fmt.Errorf("unexpected response of registry %s: %s", registry, resp.Status)
+	}
+}
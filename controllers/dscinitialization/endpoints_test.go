@@ -0,0 +1,545 @@
+package dscinitialization
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newCertificate(t *testing.T, notBefore time.Time, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "custom-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateCustomCABundle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := map[string]struct {
+		bundle string
+		status corev1.ConditionStatus
+		reason string
+	}{
+		"valid certificate": {
+			bundle: newCertificate(t, now.Add(-time.Hour), now.Add(time.Hour)),
+			status: corev1.ConditionTrue,
+			reason: status.EndpointValidReason,
+		},
+		"expired certificate": {
+			bundle: newCertificate(t, now.Add(-time.Hour), now.Add(time.Hour)) + newCertificate(t, now.Add(-2*time.Hour), now.Add(-time.Hour)),
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+		"not a certificate": {
+			bundle: "not a certificate",
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			instance := dsciv1.DSCInitialization{}
+			instance.Spec.TrustedCABundle = &dsciv1.TrustedCABundleSpec{
+				ManagementState: operatorv1.Managed,
+				CustomCABundle:  tt.bundle,
+			}
+
+			c := validateCustomCABundle(ctx, nil, &instance)
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status))
+			g.Expect(c.Reason).Should(Equal(tt.reason))
+		})
+	}
+}
+
+func TestValidateSecretStore(t *testing.T) {
+	ctx := context.Background()
+
+	newStore := func(ready string) *unstructured.Unstructured {
+		store := resources.GvkToUnstructured(gvk.ClusterSecretStore)
+		store.SetName("vault")
+		store.Object["status"] = map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": ready, "message": "some message"},
+			},
+		}
+
+		return store
+	}
+
+	tests := map[string]struct {
+		store  *unstructured.Unstructured
+		status corev1.ConditionStatus
+		reason string
+	}{
+		"ready store":     {store: newStore("True"), status: corev1.ConditionTrue, reason: status.EndpointValidReason},
+		"not ready store": {store: newStore("False"), status: corev1.ConditionFalse, reason: status.EndpointAuthRejectedReason},
+		"missing store":   {status: corev1.ConditionFalse, reason: status.EndpointUnreachableReason},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			builder := clientFake.NewClientBuilder().WithScheme(runtime.NewScheme())
+			if tt.store != nil {
+				builder = builder.WithObjects(tt.store)
+			}
+
+			instance := dsciv1.DSCInitialization{}
+			instance.Spec.SecretBackend = &dsciv1.SecretBackendSpec{
+				Type: dsciv1.SecretBackendExternalSecrets,
+				ExternalSecrets: &dsciv1.ExternalSecretsSpec{
+					StoreRef: dsciv1.SecretStoreRef{Name: "vault"},
+				},
+			}
+
+			c := validateSecretStore(ctx, builder.Build(), &instance)
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status))
+			g.Expect(c.Reason).Should(Equal(tt.reason))
+		})
+	}
+}
+
+func TestValidateSecretStoreServer(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth/token/lookup-self" || r.Header.Get("X-Vault-Token") != "valid" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}))
+	defer srv.Close()
+
+	closed := httptest.NewTLSServer(http.NotFoundHandler())
+	closed.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	tests := map[string]struct {
+		server string
+		token  string
+		ca     string
+		status corev1.ConditionStatus
+		reason string
+	}{
+		"valid token":        {server: srv.URL, token: "valid", ca: serverCA, status: corev1.ConditionTrue, reason: status.EndpointValidReason},
+		"rejected token":     {server: srv.URL, token: "invalid", ca: serverCA, status: corev1.ConditionFalse, reason: status.EndpointAuthRejectedReason},
+		"untrusted server":   {server: srv.URL, token: "valid", status: corev1.ConditionFalse, reason: status.EndpointTLSInvalidReason},
+		"unreachable server": {server: closed.URL, token: "valid", ca: serverCA, status: corev1.ConditionFalse, reason: status.EndpointUnreachableReason},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			store := resources.GvkToUnstructured(gvk.ClusterSecretStore)
+			store.SetName("vault")
+			store.Object["spec"] = map[string]any{
+				"provider": map[string]any{
+					"vault": map[string]any{
+						"server": tt.server,
+						"auth": map[string]any{
+							"tokenSecretRef": map[string]any{"name": "vault-token", "namespace": "opendatahub", "key": "token"},
+						},
+					},
+				},
+			}
+			store.Object["status"] = map[string]any{
+				"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+			}
+
+			token := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "opendatahub"},
+				Data:       map[string][]byte{"token": []byte(tt.token)},
+			}
+
+			scheme := runtime.NewScheme()
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			cli := clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(store, &token).Build()
+
+			instance := dsciv1.DSCInitialization{}
+			instance.Spec.SecretBackend = &dsciv1.SecretBackendSpec{
+				Type: dsciv1.SecretBackendExternalSecrets,
+				ExternalSecrets: &dsciv1.ExternalSecretsSpec{
+					StoreRef: dsciv1.SecretStoreRef{Name: "vault"},
+				},
+			}
+			if tt.ca != "" {
+				instance.Spec.TrustedCABundle = &dsciv1.TrustedCABundleSpec{
+					ManagementState: operatorv1.Managed,
+					CustomCABundle:  tt.ca,
+				}
+			}
+
+			c := validateSecretStore(ctx, cli, &instance)
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status), c.Message)
+			g.Expect(c.Reason).Should(Equal(tt.reason), c.Message)
+		})
+	}
+}
+
+func TestValidateSecretStoreVaultToken(t *testing.T) {
+	ctx := context.Background()
+
+	var tokens []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("X-Vault-Token"))
+		if r.Header.Get("X-Vault-Token") != "valid" {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	srv := httptest.NewTLSServer(handler)
+	defer srv.Close()
+
+	insecure := httptest.NewServer(handler)
+	defer insecure.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	newStore := func(kind schema.GroupVersionKind, namespace string, server string) *unstructured.Unstructured {
+		store := resources.GvkToUnstructured(kind)
+		store.SetName("vault")
+		store.SetNamespace(namespace)
+		store.Object["spec"] = map[string]any{
+			"provider": map[string]any{
+				"vault": map[string]any{
+					"server": server,
+					"auth": map[string]any{
+						"tokenSecretRef": map[string]any{"name": "vault-token", "namespace": "other", "key": "token"},
+					},
+				},
+			},
+		}
+		store.Object["status"] = map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+		}
+
+		return store
+	}
+
+	newToken := func(namespace string, token string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: namespace},
+			Data:       map[string][]byte{"token": []byte(token)},
+		}
+	}
+
+	tests := map[string]struct {
+		store  *unstructured.Unstructured
+		status corev1.ConditionStatus
+		reason string
+		tokens []string
+	}{
+		"namespaced store uses the token of its namespace": {
+			store:  newStore(gvk.SecretStore, "opendatahub", srv.URL),
+			status: corev1.ConditionTrue,
+			reason: status.EndpointValidReason,
+			tokens: []string{"valid"},
+		},
+		"token is not sent to an insecure server": {
+			store:  newStore(gvk.ClusterSecretStore, "", insecure.URL),
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			tokens = nil
+
+			scheme := runtime.NewScheme()
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			cli := clientFake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.store, newToken("opendatahub", "valid"), newToken("other", "other")).
+				Build()
+
+			instance := dsciv1.DSCInitialization{}
+			instance.Spec.ApplicationsNamespace = "opendatahub"
+			instance.Spec.SecretBackend = &dsciv1.SecretBackendSpec{
+				Type: dsciv1.SecretBackendExternalSecrets,
+				ExternalSecrets: &dsciv1.ExternalSecretsSpec{
+					StoreRef: dsciv1.SecretStoreRef{Name: "vault", Kind: tt.store.GetKind()},
+				},
+			}
+			instance.Spec.TrustedCABundle = &dsciv1.TrustedCABundleSpec{
+				ManagementState: operatorv1.Managed,
+				CustomCABundle:  serverCA,
+			}
+
+			c := validateSecretStore(ctx, cli, &instance)
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status), c.Message)
+			g.Expect(c.Reason).Should(Equal(tt.reason), c.Message)
+			g.Expect(tokens).Should(Equal(tt.tokens))
+		})
+	}
+}
+
+func TestValidateOIDCIssuer(t *testing.T) {
+	ctx := context.Background()
+
+	var issuer string
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"issuer": %q}`, issuer)
+	}))
+	defer srv.Close()
+
+	insecure := httptest.NewServer(http.NotFoundHandler())
+	defer insecure.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	newAuthentication := func(issuerURL string, caName string) *unstructured.Unstructured {
+		auth := resources.GvkToUnstructured(gvk.OpenshiftAuthentication)
+		auth.SetName("cluster")
+		auth.Object["spec"] = map[string]any{
+			"type": "OIDC",
+			"oidcProviders": []any{
+				map[string]any{
+					"name": "keycloak",
+					"issuer": map[string]any{
+						"issuerURL":            issuerURL,
+						"certificateAuthority": map[string]any{"name": caName},
+					},
+				},
+			},
+		}
+
+		return auth
+	}
+
+	providerCA := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-ca", Namespace: "openshift-config"},
+		Data:       map[string]string{"ca-bundle.crt": serverCA},
+	}
+
+	tests := map[string]struct {
+		auth   *unstructured.Unstructured
+		issuer string
+		status corev1.ConditionStatus
+		reason string
+	}{
+		"valid issuer": {
+			auth:   newAuthentication(srv.URL, "keycloak-ca"),
+			issuer: srv.URL,
+			status: corev1.ConditionTrue,
+			reason: status.EndpointValidReason,
+		},
+		"untrusted issuer": {
+			auth:   newAuthentication(srv.URL, ""),
+			issuer: srv.URL,
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+		"mismatched issuer": {
+			auth:   newAuthentication(srv.URL, "keycloak-ca"),
+			issuer: "https://keycloak.example.com",
+			status: corev1.ConditionFalse,
+			reason: status.EndpointUnreachableReason,
+		},
+		"insecure issuer": {
+			auth:   newAuthentication(insecure.URL, ""),
+			issuer: insecure.URL,
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			issuer = tt.issuer
+
+			scheme := runtime.NewScheme()
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			cli := clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.auth, &providerCA).Build()
+
+			c := validateOIDCIssuer(ctx, cli, &dsciv1.DSCInitialization{})
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status), c.Message)
+			g.Expect(c.Reason).Should(Equal(tt.reason), c.Message)
+		})
+	}
+}
+
+func TestValidateImageMirrors(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	closed := httptest.NewTLSServer(http.NotFoundHandler())
+	closed.Close()
+
+	registry := srv.Listener.Addr().String()
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	newMirrorSet := func(mirror string) *unstructured.Unstructured {
+		idms := resources.GvkToUnstructured(gvk.ImageDigestMirrorSet)
+		idms.SetName("mirrors")
+		idms.Object["spec"] = map[string]any{
+			"imageDigestMirrors": []any{
+				map[string]any{
+					"source":  "quay.io/opendatahub",
+					"mirrors": []any{mirror + "/opendatahub"},
+				},
+			},
+		}
+
+		return idms
+	}
+
+	image := resources.GvkToUnstructured(gvk.OpenshiftImage)
+	image.SetName("cluster")
+	image.Object["spec"] = map[string]any{
+		"additionalTrustedCA": map[string]any{"name": "registry-cas"},
+	}
+
+	tests := map[string]struct {
+		objs   []client.Object
+		status corev1.ConditionStatus
+		reason string
+	}{
+		"reachable mirror": {
+			objs: []client.Object{
+				newMirrorSet(registry),
+				image,
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "registry-cas", Namespace: "openshift-config"},
+					Data:       map[string]string{strings.ReplaceAll(registry, ":", ".."): serverCA},
+				},
+			},
+			status: corev1.ConditionTrue,
+			reason: status.EndpointValidReason,
+		},
+		"untrusted mirror": {
+			objs:   []client.Object{newMirrorSet(registry)},
+			status: corev1.ConditionFalse,
+			reason: status.EndpointTLSInvalidReason,
+		},
+		"unreachable mirror": {
+			objs:   []client.Object{newMirrorSet(closed.Listener.Addr().String())},
+			status: corev1.ConditionFalse,
+			reason: status.EndpointUnreachableReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			cli := clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).Build()
+
+			c := validateImageMirrors(ctx, cli, &dsciv1.DSCInitialization{})
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Status).Should(Equal(tt.status), c.Message)
+			g.Expect(c.Reason).Should(Equal(tt.reason), c.Message)
+		})
+	}
+}
+
+func TestEndpointsValidatorValidate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	v := newEndpointsValidator(nil, nil)
+	defer v.queue.ShutDown()
+
+	instance := dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+
+	g.Expect(v.Validate(ctx, &instance)).Should(Equal(endpointsRevalidationPeriod))
+	g.Expect(v.queue.Len()).Should(Equal(1))
+
+	// unchanged endpoints are not validated again before the revalidation period
+	g.Expect(v.Validate(ctx, &instance)).Should(And(BeNumerically(">", 0), BeNumerically("<=", endpointsRevalidationPeriod)))
+	g.Expect(v.queue.Len()).Should(Equal(1))
+
+	v.Prune([]dsciv1.DSCInitialization{instance})
+	g.Expect(v.validations).Should(HaveKey(instance.Name))
+
+	v.Prune(nil)
+	g.Expect(v.validations).ShouldNot(HaveKey(instance.Name))
+}
+
+func TestValidateEndpointsNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	instance := dsciv1.DSCInitialization{}
+	cli := clientFake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	for _, c := range endpointChecks {
+		g.Expect(c.validate(ctx, cli, &instance)).Should(BeNil())
+	}
+}
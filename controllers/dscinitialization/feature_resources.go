@@ -2,8 +2,13 @@ package dscinitialization
 
 import (
 	"embed"
-	"io/fs"
 	"path"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
 )
 
 //go:embed resources
@@ -18,14 +23,41 @@ var Templates = struct {
 	AuthorinoDir string
 	// MetricsDir is the path to the Metrics Collection templates.
 	MetricsDir string
-	// Location specifies the file system that contains the templates to be used.
-	Location fs.FS
+	// Location specifies the file system that contains the templates to be used, resolved for the
+	// flavor of the cluster.
+	Location *overlays.FS
 	// BaseDir is the path to the base of the embedded FS
 	BaseDir string
 }{
 	ServiceMeshDir: path.Join(baseDir, "servicemesh"),
 	AuthorinoDir:   path.Join(baseDir, "authorino"),
 	MetricsDir:     path.Join(baseDir, "metrics-collection"),
-	Location:       dsciEmbeddedFS,
+	Location:       overlays.New(dsciEmbeddedFS, baseDir, overlays.WithData(templatesData)),
 	BaseDir:        baseDir,
 }
+
+// templatesData returns representative data of the Service Mesh features, so that the templates can be
+// executed on every flavor without a cluster.
+func templatesData() (any, error) {
+	spec := dsciv1.DSCInitializationSpec{
+		ApplicationsNamespace: "opendatahub",
+		ServiceMesh: &infrav1.ServiceMeshSpec{
+			ControlPlane: infrav1.ControlPlaneSpec{
+				Name:              "data-science-smcp",
+				Namespace:         "istio-system",
+				MetricsCollection: "Istio",
+				IngressGateway: &infrav1.IngressGatewaySpec{
+					Autoscaling: &infrav1.GatewayAutoscalingSpec{
+						MinReplicas:                    1,
+						MaxReplicas:                    3,
+						TargetCPUUtilizationPercentage: 80,
+					},
+				},
+			},
+		},
+	}
+
+	entries := []feature.Action{servicemesh.FeatureData.ControlPlane.Define(&spec).AsAction()}
+
+	return feature.Data(spec.ApplicationsNamespace, append(entries, servicemesh.FeatureData.Authorization.All(&spec)...)...)
+}
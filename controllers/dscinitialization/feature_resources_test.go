@@ -0,0 +1,107 @@
+package dscinitialization
+
+import (
+	"path"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
+
+	. "github.com/onsi/gomega"
+)
+
+func renderSMCP(t *testing.T, flavor cluster.Flavor, controlPlane infrav1.ControlPlaneSpec) *unstructured.Unstructured {
+	t.Helper()
+	g := NewWithT(t)
+
+	location := overlays.New(dsciEmbeddedFS, baseDir, overlays.WithFlavor(flavor))
+
+	objs, err := manifest.Create(location, path.Join(Templates.ServiceMeshDir, "create-smcp.tmpl.yaml")).
+		Process(map[string]any{
+			"ControlPlane": controlPlane,
+		})
+
+	g.Expect(err).ShouldNot(HaveOccurred(), "flavor %s", flavor)
+	g.Expect(objs).Should(HaveLen(1), "flavor %s", flavor)
+
+	return objs[0]
+}
+
+func TestTemplatesOverlays(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Templates.Location.Validate()).Should(Succeed())
+	g.Expect(Templates.Location.Parse()).Should(Succeed())
+	g.Expect(Templates.Location.Execute()).Should(Succeed())
+}
+
+func TestCreateSMCPTemplateTLS(t *testing.T) {
+	g := NewWithT(t)
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+
+	for _, flavor := range cluster.Flavors {
+		smcp := renderSMCP(t, flavor, controlPlane)
+		g.Expect(smcp.GetName()).Should(Equal("data-science-smcp"))
+
+		tls, found, err := unstructured.NestedMap(smcp.Object, "spec", "security", "controlPlane", "tls")
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		if flavor != cluster.OpenShiftFIPS {
+			g.Expect(found).Should(BeFalse(), "flavor %s", flavor)
+			continue
+		}
+
+		g.Expect(found).Should(BeTrue())
+		g.Expect(tls).Should(HaveKeyWithValue("minProtocolVersion", "TLSv1_2"))
+		g.Expect(tls).Should(HaveKeyWithValue("ecdhCurves", ConsistOf("CurveP256", "CurveP384")))
+	}
+}
+
+func TestCreateSMCPTemplateIngressGatewayAutoscaling(t *testing.T) {
+	g := NewWithT(t)
+
+	autoScalingPath := []string{"spec", "gateways", "ingress", "runtime", "deployment", "autoScaling"}
+
+	for _, flavor := range []cluster.Flavor{cluster.OpenShift, cluster.OpenShiftFIPS} {
+		unset := renderSMCP(t, flavor, infrav1.ControlPlaneSpec{
+			Name:           "data-science-smcp",
+			Namespace:      "istio-system",
+			IngressGateway: &infrav1.IngressGatewaySpec{},
+		})
+
+		_, found, err := unstructured.NestedMap(unset.Object, autoScalingPath...)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(found).Should(BeFalse(), "flavor %s", flavor)
+
+		set := renderSMCP(t, flavor, infrav1.ControlPlaneSpec{
+			Name:      "data-science-smcp",
+			Namespace: "istio-system",
+			IngressGateway: &infrav1.IngressGatewaySpec{
+				Autoscaling: &infrav1.GatewayAutoscalingSpec{
+					MinReplicas:                    2,
+					MaxReplicas:                    5,
+					TargetCPUUtilizationPercentage: 75,
+				},
+			},
+		})
+
+		autoScaling, found, err := unstructured.NestedMap(set.Object, autoScalingPath...)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(found).Should(BeTrue(), "flavor %s", flavor)
+		g.Expect(autoScaling).Should(Equal(map[string]any{
+			"enabled":                        true,
+			"minReplicas":                    int64(2),
+			"maxReplicas":                    int64(5),
+			"targetCPUUtilizationPercentage": int64(75),
+		}), "flavor %s", flavor)
+
+		labels, _, err := unstructured.NestedStringMap(set.Object, "spec", "gateways", "ingress", "service", "metadata", "labels")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(labels).Should(HaveKeyWithValue("knative", "ingressgateway"), "flavor %s", flavor)
+	}
+}
@@ -8,6 +8,11 @@ package dscinitialization
 
 /* Auth */
 // +kubebuilder:rbac:groups="config.openshift.io",resources=authentications,verbs=get;watch;list
+// +kubebuilder:rbac:groups="config.openshift.io",resources=infrastructures,verbs=get
+
+/* Endpoints Validation */
+// +kubebuilder:rbac:groups="config.openshift.io",resources=images,verbs=get
+// +kubebuilder:rbac:groups="config.openshift.io",resources=imagedigestmirrorsets;imagetagmirrorsets,verbs=get;list;watch
 
 /* Service Mesh Integration */
 // +kubebuilder:rbac:groups="maistra.io",resources=servicemeshcontrolplanes,verbs=create;get;list;patch;update;use;watch
@@ -23,6 +28,10 @@ package dscinitialization
 // +kubebuilder:rbac:groups="authorino.kuadrant.io",resources=authconfigs,verbs=*
 // +kubebuilder:rbac:groups="operator.authorino.kuadrant.io",resources=authorinos,verbs=*
 
+/* Secret Backends */
+// +kubebuilder:rbac:groups="external-secrets.io",resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="external-secrets.io",resources=secretstores;clustersecretstores,verbs=get;list;watch
+
 // TODO: move to monitoring own file
 // +kubebuilder:rbac:groups="route.openshift.io",resources=routers/metrics,verbs=get
 // +kubebuilder:rbac:groups="route.openshift.io",resources=routers/federate,verbs=get
@@ -46,3 +55,8 @@ package dscinitialization
 //+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=monitorings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=monitorings/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=monitorings/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=datascienceprojects,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=datascienceprojects/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=datascienceprojects/finalizers,verbs=update
+// +kubebuilder:rbac:groups="core",resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
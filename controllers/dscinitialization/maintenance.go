@@ -0,0 +1,38 @@
+package dscinitialization
+
+import (
+	"context"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+)
+
+// serviceMeshDeferral returns how long the changes to the Service Mesh configuration must be deferred
+// according to the maintenance windows of the instance, zero if they can be applied now. The initial
+// setup of the capability is never deferred, and invalid windows defer the changes until they are fixed.
+func serviceMeshDeferral(ctx context.Context, instance *dsciv1.DSCInitialization, now time.Time) time.Duration {
+	if instance.Spec.ServiceMesh == nil || instance.Spec.ServiceMesh.ManagementState != operatorv1.Managed {
+		return 0
+	}
+
+	if !conditionsv1.IsStatusConditionTrue(instance.Status.Conditions, status.CapabilityServiceMesh) {
+		return 0
+	}
+
+	state, err := maintenance.Evaluate(instance.Spec.Maintenance, maintenance.ServiceMeshCapability, now)
+	switch {
+	case err != nil:
+		logf.FromContext(ctx).Error(err, "deferring Service Mesh changes until the maintenance windows are fixed")
+		return maintenance.RecheckPeriod
+	case state.Open:
+		return 0
+	default:
+		return min(state.Next.Sub(now), maintenance.RecheckPeriod)
+	}
+}
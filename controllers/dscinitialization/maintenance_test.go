@@ -0,0 +1,68 @@
+package dscinitialization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceMeshDeferral(t *testing.T) {
+	ctx := context.Background()
+
+	// a Saturday
+	now := time.Date(2024, time.May, 18, 23, 0, 0, 0, time.UTC)
+
+	newInstance := func(ready bool, schedule string, targets ...string) *dsciv1.DSCInitialization {
+		instance := dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ServiceMesh: &infrav1.ServiceMeshSpec{ManagementState: operatorv1.Managed},
+				Maintenance: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{{
+					Schedule: schedule,
+					Duration: metav1.Duration{Duration: time.Hour},
+					Targets:  targets,
+				}}},
+			},
+		}
+
+		if ready {
+			conditionsv1.SetStatusCondition(&instance.Status.Conditions, conditionsv1.Condition{
+				Type:   status.CapabilityServiceMesh,
+				Status: corev1.ConditionTrue,
+			})
+		}
+
+		return &instance
+	}
+
+	tests := map[string]struct {
+		instance *dsciv1.DSCInitialization
+		deferral time.Duration
+	}{
+		"initial setup":            {instance: newInstance(false, "0 2 * * SUN"), deferral: 0},
+		"window of other targets":  {instance: newInstance(true, "0 2 * * SUN", "dashboard"), deferral: 0},
+		"open window":              {instance: newInstance(true, "30 22 * * *", maintenance.ServiceMeshCapability), deferral: 0},
+		"closed window":            {instance: newInstance(true, "5 23 * * *", maintenance.ServiceMeshCapability), deferral: 5 * time.Minute},
+		"closed window recheck":    {instance: newInstance(true, "0 2 * * SUN"), deferral: maintenance.RecheckPeriod},
+		"invalid window":           {instance: newInstance(true, "0 2 * *"), deferral: maintenance.RecheckPeriod},
+		"service mesh not managed": {instance: &dsciv1.DSCInitialization{}, deferral: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(serviceMeshDeferral(ctx, tt.instance, now)).Should(Equal(tt.deferral))
+		})
+	}
+}
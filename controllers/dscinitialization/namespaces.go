@@ -0,0 +1,117 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// namespaceTerminationRecheckPeriod is how often a deleted platform namespace is checked until its
+// termination completes.
+const namespaceTerminationRecheckPeriod = 10 * time.Second
+
+// platformNamespaces returns the namespaces created by the operator for the instance: the applications
+// and monitoring namespaces and, when Service Mesh is managed, the control plane (gateway) namespace and
+// the authorization one, which is only created when Authorino is installed.
+func platformNamespaces(instance *dsciv1.DSCInitialization, authorization bool) []string {
+	names := []string{instance.Spec.ApplicationsNamespace}
+
+	if instance.Spec.Monitoring.ManagementState == operatorv1.Managed && instance.Spec.Monitoring.Namespace != "" {
+		names = append(names, instance.Spec.Monitoring.Namespace)
+	}
+
+	if instance.Spec.ServiceMesh != nil && instance.Spec.ServiceMesh.ManagementState == operatorv1.Managed {
+		if ns := instance.Spec.ServiceMesh.ControlPlane.Namespace; ns != "" {
+			names = append(names, ns)
+		}
+
+		if !authorization {
+			return names
+		}
+
+		authNs := strings.TrimSpace(instance.Spec.ServiceMesh.Auth.Namespace)
+		if authNs == "" {
+			authNs = instance.Spec.ApplicationsNamespace + "-auth-provider"
+		}
+		names = append(names, authNs)
+	}
+
+	return names
+}
+
+// namespacesState is the state of the platform namespaces at the beginning of a reconciliation.
+type namespacesState struct {
+	Terminating []string
+	Missing     []string
+}
+
+func observeNamespaces(ctx context.Context, cli client.Client, names []string) (namespacesState, error) {
+	state := namespacesState{}
+
+	for _, name := range names {
+		ns := &corev1.Namespace{}
+		err := cli.Get(ctx, client.ObjectKey{Name: name}, ns)
+		switch {
+		case k8serr.IsNotFound(err):
+			state.Missing = append(state.Missing, name)
+		case err != nil:
+			return state, fmt.Errorf("failed to get namespace %s: %w", name, err)
+		case !ns.DeletionTimestamp.IsZero():
+			state.Terminating = append(state.Terminating, name)
+		}
+	}
+
+	return state, nil
+}
+
+// Recovering reports whether some platform namespaces have been deleted out-of-band and their features
+// still have to be rebuilt. Namespaces missing before the instance is ready for the first time are part
+// of the installation, not of a recovery.
+func (s namespacesState) Recovering(instance *dsciv1.DSCInitialization) bool {
+	if len(s.Terminating) > 0 {
+		return true
+	}
+
+	if conditionsv1.IsStatusConditionFalse(instance.Status.Conditions, status.ConditionPlatformNamespaces) {
+		return true
+	}
+
+	return len(s.Missing) > 0 && instance.Status.Phase == status.PhaseReady
+}
+
+// Condition returns the condition reporting the progress of the recovery.
+func (s namespacesState) Condition() conditionsv1.Condition {
+	switch {
+	case len(s.Terminating) > 0:
+		return conditionsv1.Condition{
+			Type:    status.ConditionPlatformNamespaces,
+			Status:  corev1.ConditionFalse,
+			Reason:  status.NamespaceTerminatingReason,
+			Message: fmt.Sprintf("Waiting for namespaces %s to be terminated before re-creating them", strings.Join(s.Terminating, ", ")),
+		}
+	case len(s.Missing) > 0:
+		return conditionsv1.Condition{
+			Type:    status.ConditionPlatformNamespaces,
+			Status:  corev1.ConditionFalse,
+			Reason:  status.NamespaceRecoveringReason,
+			Message: fmt.Sprintf("Re-creating namespaces %s and their resources", strings.Join(s.Missing, ", ")),
+		}
+	default:
+		return conditionsv1.Condition{
+			Type:    status.ConditionPlatformNamespaces,
+			Status:  corev1.ConditionFalse,
+			Reason:  status.NamespaceRecoveringReason,
+			Message: "Rebuilding the features of the re-created namespaces",
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package dscinitialization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPlatformNamespaces(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &dsciv1.DSCInitialization{
+		Spec: dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			Monitoring: serviceApi.DSCMonitoring{
+				ManagementSpec:       common.ManagementSpec{ManagementState: operatorv1.Removed},
+				MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{Namespace: "odh-monitoring"},
+			},
+		},
+	}
+	g.Expect(platformNamespaces(instance, true)).Should(ConsistOf("opendatahub"))
+
+	instance.Spec.Monitoring.ManagementState = operatorv1.Managed
+	instance.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
+		ManagementState: operatorv1.Managed,
+		ControlPlane:    infrav1.ControlPlaneSpec{Namespace: "istio-system"},
+	}
+	g.Expect(platformNamespaces(instance, true)).Should(
+		ConsistOf("opendatahub", "odh-monitoring", "istio-system", "opendatahub-auth-provider"))
+
+	// the authorization namespace is not created without Authorino, it would be reported as missing forever
+	g.Expect(platformNamespaces(instance, false)).Should(
+		ConsistOf("opendatahub", "odh-monitoring", "istio-system"))
+}
+
+func TestObserveNamespaces(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+
+	terminating := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              "odh-monitoring",
+		DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		Finalizers:        []string{"kubernetes"},
+	}}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub"}},
+		terminating,
+	).Build()
+
+	state, err := observeNamespaces(ctx, cli, []string{"opendatahub", "odh-monitoring", "istio-system"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(state.Terminating).Should(ConsistOf("odh-monitoring"))
+	g.Expect(state.Missing).Should(ConsistOf("istio-system"))
+	g.Expect(state.Condition().Reason).Should(Equal(status.NamespaceTerminatingReason))
+}
+
+func TestNamespacesRecovering(t *testing.T) {
+	recoveringCondition := conditionsv1.Condition{
+		Type:   status.ConditionPlatformNamespaces,
+		Status: corev1.ConditionFalse,
+		Reason: status.NamespaceRecoveringReason,
+	}
+
+	tests := map[string]struct {
+		state      namespacesState
+		phase      string
+		conditions []conditionsv1.Condition
+		recovering bool
+	}{
+		"all namespaces available": {
+			phase: status.PhaseReady,
+		},
+		"installation": {
+			state: namespacesState{Missing: []string{"opendatahub"}},
+			phase: status.PhaseProgressing,
+		},
+		"deleted namespace": {
+			state:      namespacesState{Missing: []string{"opendatahub"}},
+			phase:      status.PhaseReady,
+			recovering: true,
+		},
+		"terminating namespace": {
+			state:      namespacesState{Terminating: []string{"opendatahub"}},
+			phase:      status.PhaseProgressing,
+			recovering: true,
+		},
+		"features not rebuilt yet": {
+			phase:      status.PhaseReady,
+			conditions: []conditionsv1.Condition{recoveringCondition},
+			recovering: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			instance := &dsciv1.DSCInitialization{
+				Status: dsciv1.DSCInitializationStatus{Phase: tt.phase, Conditions: tt.conditions},
+			}
+			g.Expect(tt.state.Recovering(instance)).Should(Equal(tt.recovering))
+		})
+	}
+}
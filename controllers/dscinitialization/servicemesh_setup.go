@@ -160,6 +160,7 @@ func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *ds
 							path.Join(Templates.MetricsDir),
 						),
 				).
+				Uncached().
 				WithData(
 					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
 				).
@@ -192,6 +193,7 @@ func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSC
 							path.Join(Templates.AuthorinoDir, "mesh-authz-ext-provider.patch.tmpl.yaml"),
 						),
 				).
+				Uncached().
 				WithData(
 					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
 				).
@@ -0,0 +1,159 @@
+package secretgenerator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/externalsecrets"
+)
+
+const generatedSuffix = "-generated"
+
+// ErrNotSynchronized is returned when the value of a secret is not available yet, the reconciliation
+// is then retried later.
+var ErrNotSynchronized = errors.New("the secret has not been synchronized yet")
+
+// Backend keeps the value of the secrets requested through the secret-generator annotations.
+type Backend interface {
+	// Exists returns true if the value requested by the annotated source Secret is already provided.
+	Exists(ctx context.Context, source *corev1.Secret) (bool, error)
+	// Store makes the value of the secret available in the <source name>-generated Secret.
+	Store(ctx context.Context, source *corev1.Secret, secret *Secret) error
+	// Value returns the value of the secret as seen by its consumers.
+	Value(ctx context.Context, source *corev1.Secret, secret *Secret) (string, error)
+}
+
+// NewBackend returns the Backend configured in the DSCInitialization, if no backend is
+// configured the generated values are stored in Kubernetes Secrets.
+func NewBackend(cli client.Client, spec *dsciv1.SecretBackendSpec) Backend {
+	if externalsecrets.Enabled(spec) {
+		return &externalSecretsBackend{client: cli, spec: *spec.ExternalSecrets}
+	}
+
+	return &kubernetesBackend{client: cli}
+}
+
+func generatedName(source *corev1.Secret) string {
+	return source.Name + generatedSuffix
+}
+
+// kubernetesBackend stores the randomly generated value in a Secret owned by the source one.
+type kubernetesBackend struct {
+	client client.Client
+}
+
+func (b *kubernetesBackend) Exists(ctx context.Context, source *corev1.Secret) (bool, error) {
+	generated := corev1.Secret{}
+
+	err := b.client.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: generatedName(source)}, &generated)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+func (b *kubernetesBackend) Store(ctx context.Context, source *corev1.Secret, secret *Secret) error {
+	generated := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatedName(source),
+			Namespace: source.Namespace,
+			Labels:    source.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(source, source.GroupVersionKind()),
+			},
+		},
+		StringData: map[string]string{
+			secret.Name: secret.Value,
+		},
+	}
+
+	return b.client.Create(ctx, &generated)
+}
+
+func (b *kubernetesBackend) Value(_ context.Context, _ *corev1.Secret, secret *Secret) (string, error) {
+	return secret.Value, nil
+}
+
+// externalSecretsBackend creates an ExternalSecret owned by the source Secret, the External Secrets
+// Operator then reads the value from the configured store and synchronizes it to the generated Secret.
+// The value of the secret is never generated by the operator.
+type externalSecretsBackend struct {
+	client client.Client
+	spec   dsciv1.ExternalSecretsSpec
+}
+
+// Exists returns true once the generated Secret has been synchronized, so that the value of a secret
+// which is still pending is read again on the next reconciliation.
+func (b *externalSecretsBackend) Exists(ctx context.Context, source *corev1.Secret) (bool, error) {
+	es := unstructured.Unstructured{}
+	es.SetGroupVersionKind(gvk.ExternalSecret)
+
+	err := b.client.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: generatedName(source)}, &es)
+	switch {
+	case meta.IsNoMatchError(err):
+		return false, externalsecrets.ErrNotInstalled
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	generated := corev1.Secret{}
+
+	err = b.client.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: generatedName(source)}, &generated)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+func (b *externalSecretsBackend) Store(ctx context.Context, source *corev1.Secret, secret *Secret) error {
+	es := externalsecrets.New(b.spec,
+		generatedName(source),
+		source.Namespace,
+		externalsecrets.Key(b.spec, source.Namespace, source.Name),
+		"",
+		source.Labels,
+		secret.Name)
+
+	return externalsecrets.Create(ctx, b.client, es,
+		cluster.WithOwnerReference(*metav1.NewControllerRef(source, source.GroupVersionKind())))
+}
+
+// Value returns the value synchronized by the External Secrets Operator, or ErrNotSynchronized if the
+// generated Secret is not synchronized yet.
+func (b *externalSecretsBackend) Value(ctx context.Context, source *corev1.Secret, secret *Secret) (string, error) {
+	generated := corev1.Secret{}
+
+	err := b.client.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: generatedName(source)}, &generated)
+	if client.IgnoreNotFound(err) != nil {
+		return "", err
+	}
+
+	value, found := generated.Data[secret.Name]
+	if !found {
+		return "", fmt.Errorf("%w: secret %s/%s from store %s",
+			ErrNotSynchronized, source.Namespace, generatedName(source), b.spec.StoreRef.Name)
+	}
+
+	return string(value), nil
+}
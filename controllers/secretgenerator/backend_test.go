@@ -0,0 +1,141 @@
+package secretgenerator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/secretgenerator"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+
+	. "github.com/onsi/gomega"
+)
+
+func newSourceSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dashboard-oauth-config",
+			Namespace: "opendatahub",
+			UID:       "source-uid",
+			Labels:    map[string]string{"app": "dashboard"},
+		},
+	}
+}
+
+func newFakeClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+
+	return clientFake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+func TestKubernetesBackend(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cli := newFakeClient(t)
+	source := newSourceSecret()
+	secret := &secretgenerator.Secret{Name: "cookie-secret", Value: "generated-value"}
+
+	backend := secretgenerator.NewBackend(cli, nil)
+
+	exists, err := backend.Exists(ctx, source)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exists).Should(BeFalse())
+
+	g.Expect(backend.Store(ctx, source, secret)).Should(Succeed())
+
+	exists, err = backend.Exists(ctx, source)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exists).Should(BeTrue())
+
+	generated := corev1.Secret{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: source.Name + "-generated"}, &generated)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(generated.StringData).Should(HaveKeyWithValue("cookie-secret", "generated-value"))
+	g.Expect(generated.Labels).Should(HaveKeyWithValue("app", "dashboard"))
+	g.Expect(generated.OwnerReferences).Should(HaveLen(1))
+
+	value, err := backend.Value(ctx, source, secret)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(value).Should(Equal("generated-value"))
+}
+
+func TestExternalSecretsBackend(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cli := newFakeClient(t)
+	source := newSourceSecret()
+	secret := &secretgenerator.Secret{Name: "cookie-secret", Value: "generated-value"}
+
+	backend := secretgenerator.NewBackend(cli, &dsciv1.SecretBackendSpec{
+		Type: dsciv1.SecretBackendExternalSecrets,
+		ExternalSecrets: &dsciv1.ExternalSecretsSpec{
+			StoreRef:        dsciv1.SecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"},
+			KeyPrefix:       "odh/",
+			RefreshInterval: metav1.Duration{Duration: 15 * time.Minute},
+		},
+	})
+
+	g.Expect(backend.Store(ctx, source, secret)).Should(Succeed())
+
+	// the secret is still pending until the External Secrets Operator synchronizes it
+	exists, err := backend.Exists(ctx, source)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exists).Should(BeFalse())
+
+	_, err = backend.Value(ctx, source, secret)
+	g.Expect(err).Should(MatchError(secretgenerator.ErrNotSynchronized))
+
+	// storing a pending secret again is a no-op
+	g.Expect(backend.Store(ctx, source, secret)).Should(Succeed())
+
+	es := unstructured.Unstructured{}
+	es.SetGroupVersionKind(gvk.ExternalSecret)
+
+	err = cli.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: source.Name + "-generated"}, &es)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(es.GetOwnerReferences()).Should(HaveLen(1))
+
+	refreshInterval, _, _ := unstructured.NestedString(es.Object, "spec", "refreshInterval")
+	g.Expect(refreshInterval).Should(Equal("15m0s"))
+
+	store, _, _ := unstructured.NestedStringMap(es.Object, "spec", "secretStoreRef")
+	g.Expect(store).Should(Equal(map[string]string{"name": "vault", "kind": "ClusterSecretStore"}))
+
+	data, _, _ := unstructured.NestedSlice(es.Object, "spec", "data")
+	g.Expect(data).Should(ConsistOf(map[string]any{
+		"secretKey": "cookie-secret",
+		"remoteRef": map[string]any{
+			"key":      "odh/opendatahub/dashboard-oauth-config",
+			"property": "cookie-secret",
+		},
+	}))
+
+	// the value is never generated by the operator, it is the one synchronized from the store
+	g.Expect(cli.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: source.Name + "-generated", Namespace: source.Namespace},
+		Data:       map[string][]byte{"cookie-secret": []byte("value-from-vault")},
+	})).Should(Succeed())
+
+	exists, err = backend.Exists(ctx, source)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exists).Should(BeTrue())
+
+	value, err := backend.Value(ctx, source, secret)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(value).Should(Equal("value-from-vault"))
+}
@@ -20,6 +20,7 @@ package secretgenerator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	odhClient "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/client"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -121,20 +123,22 @@ func (r *SecretGeneratorReconciler) Reconcile(ctx context.Context, request ctrl.
 		return ctrl.Result{}, nil
 	}
 
-	// Generate the secret if it does not previously exist
-	generatedSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      foundSecret.Name + "-generated",
-			Namespace: foundSecret.Namespace,
-		},
+	backend, err := r.backend(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
-	err = r.Client.Get(ctx, client.ObjectKeyFromObject(generatedSecret), generatedSecret)
-	if err == nil || !k8serr.IsNotFound(err) {
+	// Generate the secret if it does not previously exist
+	exists, err := backend.Exists(ctx, foundSecret)
+	if err != nil || exists {
 		return ctrl.Result{}, err
 	}
 
-	err = r.generateSecret(ctx, foundSecret, generatedSecret)
+	err = r.generateSecret(ctx, backend, foundSecret)
+	if errors.Is(err, ErrNotSynchronized) {
+		logf.FromContext(ctx).Info("Waiting for the secret to be synchronized", "secret", request.NamespacedName, "reason", err.Error())
+		return ctrl.Result{RequeueAfter: resourceRetryInterval}, nil
+	}
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -143,36 +147,42 @@ func (r *SecretGeneratorReconciler) Reconcile(ctx context.Context, request ctrl.
 	return ctrl.Result{}, nil
 }
 
-func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSecret *corev1.Secret, generatedSecret *corev1.Secret) error {
+// backend returns the secret backend configured in the DSCInitialization.
+func (r *SecretGeneratorReconciler) backend(ctx context.Context) (Backend, error) {
+	dscis := dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, &dscis); err != nil {
+		return nil, fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	if len(dscis.Items) != 1 {
+		return NewBackend(r.Client, nil), nil
+	}
+
+	return NewBackend(r.Client, dscis.Items[0].Spec.SecretBackend), nil
+}
+
+func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, backend Backend, foundSecret *corev1.Secret) error {
 	log := logf.FromContext(ctx).WithName("SecretGenerator")
 
+	generatedName := generatedName(foundSecret)
+
 	// Generate secret random value
 	log.Info("Generating a random value for a secret in a namespace",
-		"secret", generatedSecret.Name, "namespace", generatedSecret.Namespace)
-
-	generatedSecret.Labels = foundSecret.Labels
-
-	generatedSecret.OwnerReferences = []metav1.OwnerReference{
-		*metav1.NewControllerRef(foundSecret, foundSecret.GroupVersionKind()),
-	}
+		"secret", generatedName, "namespace", foundSecret.Namespace)
 
 	secret, err := NewSecretFrom(foundSecret.GetAnnotations())
 	if err != nil {
-		log.Error(err, "error creating secret %s in %s", generatedSecret.Name, generatedSecret.Namespace)
+		log.Error(err, "error creating secret %s in %s", generatedName, foundSecret.Namespace)
 		return err
 	}
 
-	generatedSecret.StringData = map[string]string{
-		secret.Name: secret.Value,
-	}
-
-	err = r.Client.Create(ctx, generatedSecret)
+	err = backend.Store(ctx, foundSecret, secret)
 	if err != nil {
 		return err
 	}
 
 	log.Info("Done generating secret in namespace",
-		"secret", generatedSecret.Name, "namespace", generatedSecret.Namespace)
+		"secret", generatedName, "namespace", foundSecret.Namespace)
 
 	// check if annotation oauth-client-route exists
 	if secret.OAuthClientRoute == "" {
@@ -186,9 +196,14 @@ func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSec
 		return err
 	}
 
+	value, err := backend.Value(ctx, foundSecret, secret)
+	if err != nil {
+		return err
+	}
+
 	// Generate OAuthClient for the generated secret
 	log.Info("Generating an OAuthClient CR for route", "route-name", oauthClientRoute.Name)
-	err = r.createOAuthClient(ctx, foundSecret.Name, secret.Value, oauthClientRoute.Spec.Host)
+	err = r.createOAuthClient(ctx, foundSecret.Name, value, oauthClientRoute.Spec.Host)
 	if err != nil {
 		log.Error(err, "error creating oauth client resource. Recreate the Secret", "secret-name",
 			foundSecret.Name)
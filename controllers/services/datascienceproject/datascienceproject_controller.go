@@ -0,0 +1,129 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datascienceproject
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// NewServiceReconciler creates a ServiceReconciler for the DataScienceProject API.
+//
+// A DataScienceProject is provisioned into a namespace of the same name, which is left in place, together
+// with the workloads it contains, when the project is deleted: only the access, quota, mesh membership and
+// data connections granted by the project are removed.
+func NewServiceReconciler(ctx context.Context, mgr ctrl.Manager) error {
+	_, err := reconciler.ReconcilerFor(mgr, &serviceApi.DataScienceProject{}).
+		// operands - owned
+		Owns(&rbacv1.RoleBinding{}).
+		Owns(&corev1.ResourceQuota{}).
+		Owns(&corev1.Secret{}).
+		// the ServiceMeshMember CRD is only available when Service Mesh is installed
+		OwnsGVK(gvk.ServiceMeshMember, reconciler.Dynamic(serviceMeshManaged)).
+		// operands - watched
+		//
+		// The namespace is not owned, so that it is not garbage collected with
+		// the project.
+		Watches(&corev1.Namespace{}).
+		// The data connections published in the applications namespace are
+		// copied to the projects, which must be updated when they change,
+		// including when they are unpublished.
+		Watches(
+			&corev1.Secret{},
+			reconciler.WithEventMapper(dataConnectionToProjects(mgr.GetClient())),
+			reconciler.WithPredicates(dataConnectionPredicate()),
+		).
+		// actions
+		WithAction(checkPreConditions).
+		WithAction(initialize).
+		WithAction(configureNamespace).
+		WithAction(configureMembers).
+		WithAction(configureQuota).
+		WithAction(configureServiceMeshMember).
+		WithAction(configureDataConnections).
+		WithAction(deploy.NewAction(
+			deploy.WithCache(),
+		)).
+		WithAction(updateStatus).
+		// must be the final action
+		WithAction(gc.NewAction(
+			gc.WithPredicate(ownedByProject),
+		)).
+		Build(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not create the data science project controller: %w", err)
+	}
+
+	return nil
+}
+
+// dataConnectionPredicate selects the events of the data connections, and of the Secrets which stop being one.
+func dataConnectionPredicate() predicate.Funcs {
+	isDataConnection := func(obj client.Object) bool {
+		_, ok := obj.GetLabels()[labels.DataConnection]
+		return ok
+	}
+
+	p := predicate.NewPredicateFuncs(isDataConnection)
+	p.UpdateFunc = func(e event.UpdateEvent) bool {
+		return isDataConnection(e.ObjectOld) || isDataConnection(e.ObjectNew)
+	}
+
+	return p
+}
+
+// dataConnectionToProjects maps a data connection of the applications namespace to the projects it is
+// copied to, i.e. all the projects for a default data connection. On update, both the old and the new version of
+// the Secret are mapped, so that the projects are reconciled when the data connection is unpublished.
+func dataConnectionToProjects(cli client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		projects := serviceApi.DataScienceProjectList{}
+		if err := cli.List(ctx, &projects); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "failed to list DataScienceProjects")
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(projects.Items))
+		for i := range projects.Items {
+			if !usesDataConnection(&projects.Items[i], obj) {
+				continue
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKey{Name: projects.Items[i].Name},
+			})
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,367 @@
+package datascienceproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// resourceQuotaName is the name of the ResourceQuota of a project.
+	resourceQuotaName = "data-science-project"
+	// serviceMeshMemberName is the name the ServiceMeshMember of a namespace must have.
+	serviceMeshMemberName = "default"
+)
+
+func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	reserved := []string{rr.DSCI.Spec.ApplicationsNamespace, rr.DSCI.Spec.Monitoring.Namespace}
+	if operatorNamespace, err := cluster.GetOperatorNamespace(); err == nil {
+		reserved = append(reserved, operatorNamespace)
+	}
+
+	if slices.Contains(reserved, p.Name) {
+		return odherrors.NewNotReadyStopError(rr, status.ProjectNamespaceReservedReason,
+			fmt.Errorf("namespace %s is a platform namespace", p.Name))
+	}
+
+	ns := corev1.Namespace{}
+	err := rr.Client.Get(ctx, client.ObjectKey{Name: p.Name}, &ns)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to get namespace %s: %w", p.Name, err)
+	case ns.Labels[labels.ODH.OwnedNamespace] == labels.True:
+		return odherrors.NewNotReadyStopError(rr, status.ProjectNamespaceReservedReason,
+			fmt.Errorf("namespace %s is managed by the platform", p.Name))
+	case ns.Labels[labels.DataScienceProject] != p.Name:
+		// granting the members of the project access to a namespace they do not own would let anyone
+		// allowed to create a project take over any namespace
+		return odherrors.NewNotReadyStopError(rr, status.ProjectNamespaceNotOwnedReason,
+			fmt.Errorf("namespace %s already exists, label it with %s=%s for the project to adopt it",
+				p.Name, labels.DataScienceProject, p.Name))
+	}
+
+	if p.Spec.ServiceMeshMember && !serviceMeshManaged(ctx, rr) {
+		return odherrors.NewNotReadyStopError(rr, status.ServiceMeshNotConfiguredReason,
+			errors.New("the project can not join the mesh, Service Mesh must be set to Managed in the DSCInitialization"))
+	}
+
+	return nil
+}
+
+func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	// the resources left behind by a previous generation of the project are only garbage collected when
+	// the spec changes, as running the GC for each project on every reconciliation would be too expensive
+	rr.Generated = p.Generation != p.Status.ObservedGeneration
+
+	return nil
+}
+
+func configureNamespace(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	displayName := p.Spec.DisplayName
+	if displayName == "" {
+		displayName = p.Name
+	}
+
+	ns := corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: p.Name,
+			Labels: map[string]string{
+				labels.Dashboard:          labels.True,
+				labels.DataScienceProject: p.Name,
+			},
+			Annotations: map[string]string{
+				annotations.DisplayName: displayName,
+			},
+		},
+	}
+
+	if p.Spec.Description != "" {
+		ns.Annotations[annotations.Description] = p.Spec.Description
+	}
+
+	return rr.AddResources(&ns)
+}
+
+func configureMembers(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	// one RoleBinding per role, with all the members having that role as subjects
+	subjects := map[serviceApi.ProjectRole][]rbacv1.Subject{}
+
+	for _, m := range p.Spec.Members {
+		role := m.Role
+		if role == "" {
+			role = serviceApi.ProjectRoleEdit
+		}
+
+		kind := m.Kind
+		if kind == "" {
+			kind = serviceApi.ProjectMemberUser
+		}
+
+		subjects[role] = append(subjects[role], rbacv1.Subject{
+			APIGroup: rbacv1.GroupName,
+			Kind:     string(kind),
+			Name:     m.Name,
+		})
+	}
+
+	for _, role := range []serviceApi.ProjectRole{serviceApi.ProjectRoleAdmin, serviceApi.ProjectRoleEdit, serviceApi.ProjectRoleView} {
+		if len(subjects[role]) == 0 {
+			continue
+		}
+
+		err := rr.AddResources(&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "data-science-project-" + string(role),
+				Namespace: p.Name,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     string(role),
+			},
+			Subjects: subjects[role],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s role binding: %w", role, err)
+		}
+	}
+
+	return nil
+}
+
+func configureQuota(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	if len(p.Spec.Quota) == 0 {
+		return nil
+	}
+
+	return rr.AddResources(&corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceQuotaName,
+			Namespace: p.Name,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: p.Spec.Quota,
+		},
+	})
+}
+
+func configureServiceMeshMember(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	if !p.Spec.ServiceMeshMember {
+		return nil
+	}
+
+	smm := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"controlPlaneRef": map[string]any{
+				"name":      rr.DSCI.Spec.ServiceMesh.ControlPlane.Name,
+				"namespace": rr.DSCI.Spec.ServiceMesh.ControlPlane.Namespace,
+			},
+		},
+	}}
+	smm.SetGroupVersionKind(gvk.ServiceMeshMember)
+	smm.SetName(serviceMeshMemberName)
+	smm.SetNamespace(p.Name)
+
+	rr.Resources = append(rr.Resources, smm)
+
+	return nil
+}
+
+func configureDataConnections(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	published := corev1.SecretList{}
+	err := rr.Client.List(ctx, &published,
+		client.InNamespace(rr.DSCI.Spec.ApplicationsNamespace),
+		client.HasLabels{labels.DataConnection},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to list data connections: %w", err)
+	}
+
+	missing := slices.DeleteFunc(slices.Clone(p.Spec.DataConnections), func(name string) bool {
+		return slices.ContainsFunc(published.Items, func(s corev1.Secret) bool { return s.Name == name })
+	})
+	if len(missing) != 0 {
+		return odherrors.NewNotReadyStopError(rr, status.DataConnectionNotFoundReason,
+			fmt.Errorf("data connections %s are not published in namespace %s",
+				strings.Join(missing, ", "), rr.DSCI.Spec.ApplicationsNamespace))
+	}
+
+	copied := p.Status.DataConnections
+	p.Status.DataConnections = nil
+
+	for i := range published.Items {
+		s := &published.Items[i]
+		if !usesDataConnection(p, s) {
+			continue
+		}
+
+		if err := rr.AddResources(newDataConnection(s, p.Name)); err != nil {
+			return fmt.Errorf("failed to add data connection %s: %w", s.Name, err)
+		}
+
+		p.Status.DataConnections = append(p.Status.DataConnections, s.Name)
+	}
+
+	// the copies of the data connections which are not published anymore are deleted right away, as the
+	// generation of the project does not change and they would not be garbage collected, leaving revoked
+	// credentials in the project
+	for _, name := range copied {
+		if slices.Contains(p.Status.DataConnections, name) {
+			continue
+		}
+
+		if err := deleteDataConnection(ctx, rr, p, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteDataConnection deletes the copy of a data connection from a project, unless it was not made by the project.
+func deleteDataConnection(ctx context.Context, rr *odhtypes.ReconciliationRequest, p *serviceApi.DataScienceProject, name string) error {
+	s := corev1.Secret{}
+	err := rr.Client.Get(ctx, client.ObjectKey{Namespace: p.Name, Name: name}, &s)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get data connection %s: %w", name, err)
+	case resources.GetAnnotation(&s, annotations.InstanceName) != p.Name:
+		return nil
+	}
+
+	err = rr.Client.Delete(ctx, &s, client.Preconditions{UID: &s.UID})
+	if err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to delete data connection %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// newDataConnection copies a data connection published in the applications namespace to a project.
+func newDataConnection(published *corev1.Secret, namespace string) *corev1.Secret {
+	s := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      published.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				labels.Dashboard: labels.True,
+			},
+			Annotations: map[string]string{},
+		},
+		Type: published.Type,
+		Data: published.Data,
+	}
+
+	for _, a := range []string{annotations.DisplayName, annotations.ConnectionType} {
+		if v, ok := published.Annotations[a]; ok {
+			s.Annotations[a] = v
+		}
+	}
+
+	return &s
+}
+
+// usesDataConnection returns whether the published data connection is copied to the project.
+func usesDataConnection(p *serviceApi.DataScienceProject, published client.Object) bool {
+	value, ok := published.GetLabels()[labels.DataConnection]
+	if !ok {
+		return false
+	}
+
+	return value == labels.DataConnectionDefault || slices.Contains(p.Spec.DataConnections, published.GetName())
+}
+
+func updateStatus(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	p, ok := rr.Instance.(*serviceApi.DataScienceProject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.DataScienceProject)", rr.Instance)
+	}
+
+	s := p.GetStatus()
+	s.ObservedGeneration = p.Generation
+	s.Phase = status.PhaseReady
+
+	meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+		Type:               status.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             status.ProjectProvisionedReason,
+		Message:            fmt.Sprintf("namespace %s provisioned", p.Name),
+		ObservedGeneration: s.ObservedGeneration,
+	})
+
+	return nil
+}
+
+// ownedByProject restricts the garbage collection to the resources of the reconciled project, as all the
+// projects share the same part-of label.
+func ownedByProject(rr *odhtypes.ReconciliationRequest, obj unstructured.Unstructured) (bool, error) {
+	if resources.GetAnnotation(&obj, annotations.InstanceName) != rr.Instance.GetName() {
+		return false, nil
+	}
+
+	return gc.DefaultPredicate(rr, obj)
+}
+
+func serviceMeshManaged(_ context.Context, rr *odhtypes.ReconciliationRequest) bool {
+	return rr.DSCI.Spec.ServiceMesh != nil && rr.DSCI.Spec.ServiceMesh.ManagementState == operatorv1.Managed
+}
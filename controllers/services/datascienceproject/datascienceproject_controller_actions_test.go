@@ -0,0 +1,320 @@
+package datascienceproject
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	appNamespace = "opendatahub"
+	projectName  = "fraud-detection"
+)
+
+var secretTypeMeta = metav1.TypeMeta{APIVersion: gvk.Secret.Version, Kind: gvk.Secret.Kind}
+
+func newProject() *serviceApi.DataScienceProject {
+	return &serviceApi.DataScienceProject{
+		ObjectMeta: metav1.ObjectMeta{Name: projectName, Generation: 1},
+	}
+}
+
+func newRequest(t *testing.T, p *serviceApi.DataScienceProject, objs ...client.Object) *odhtypes.ReconciliationRequest {
+	t.Helper()
+
+	cli, err := fakeclient.New(objs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &odhtypes.ReconciliationRequest{
+		Client:   cli,
+		Instance: p,
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: appNamespace,
+				Monitoring: serviceApi.DSCMonitoring{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{Namespace: "odh-monitoring"},
+				},
+				ServiceMesh: &infrav1.ServiceMeshSpec{
+					ManagementState: operatorv1.Removed,
+					ControlPlane:    infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"},
+				},
+			},
+		},
+	}
+}
+
+func newNamespace(name string, l map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: l},
+	}
+}
+
+func newPublishedDataConnection(name string, value string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: secretTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   appNamespace,
+			Labels:      map[string]string{labels.DataConnection: value},
+			Annotations: map[string]string{annotations.ConnectionType: "s3"},
+		},
+		Data: map[string][]byte{"AWS_ACCESS_KEY_ID": []byte(name)},
+	}
+}
+
+func readyReason(p *serviceApi.DataScienceProject) string {
+	for _, c := range p.Status.Conditions {
+		if c.Type == status.ConditionTypeReady {
+			return c.Reason
+		}
+	}
+
+	return ""
+}
+
+func TestCheckPreConditions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		name      string
+		namespace *corev1.Namespace
+		mesh      bool
+		reason    string
+	}{
+		"new namespace": {},
+		"applications namespace": {
+			name:   appNamespace,
+			reason: status.ProjectNamespaceReservedReason,
+		},
+		"generated namespace": {
+			namespace: newNamespace(projectName, map[string]string{labels.ODH.OwnedNamespace: labels.True}),
+			reason:    status.ProjectNamespaceReservedReason,
+		},
+		"existing namespace": {
+			namespace: newNamespace(projectName, nil),
+			reason:    status.ProjectNamespaceNotOwnedReason,
+		},
+		"namespace of another project": {
+			namespace: newNamespace(projectName, map[string]string{labels.DataScienceProject: "other"}),
+			reason:    status.ProjectNamespaceNotOwnedReason,
+		},
+		"namespace of the project": {
+			namespace: newNamespace(projectName, map[string]string{labels.DataScienceProject: projectName}),
+		},
+		"mesh member without managed mesh": {
+			mesh:   true,
+			reason: status.ServiceMeshNotConfiguredReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			p := newProject()
+			p.Spec.ServiceMeshMember = tt.mesh
+			if tt.name != "" {
+				p.Name = tt.name
+			}
+
+			var objs []client.Object
+			if tt.namespace != nil {
+				objs = append(objs, tt.namespace)
+			}
+
+			err := checkPreConditions(ctx, newRequest(t, p, objs...))
+
+			if tt.reason == "" {
+				g.Expect(err).ShouldNot(HaveOccurred())
+				return
+			}
+
+			g.Expect(errors.As(err, &odherrors.StopError{})).Should(BeTrue())
+			g.Expect(readyReason(p)).Should(Equal(tt.reason))
+		})
+	}
+}
+
+func TestConfigureNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	p := newProject()
+	rr := newRequest(t, p)
+
+	g.Expect(configureNamespace(context.Background(), rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveExactElements(And(
+		jq.Match(`.kind == "Namespace"`),
+		jq.Match(`.metadata.labels."%s" == "%s"`, labels.DataScienceProject, projectName),
+		jq.Match(`.metadata.annotations."%s" == "%s"`, annotations.DisplayName, projectName),
+	)))
+}
+
+func TestConfigureMembers(t *testing.T) {
+	g := NewWithT(t)
+
+	p := newProject()
+	p.Spec.Members = []serviceApi.ProjectMember{
+		{Name: "alice", Role: serviceApi.ProjectRoleAdmin},
+		{Name: "bob"},
+		{Name: "data-scientists", Kind: serviceApi.ProjectMemberGroup},
+		{Name: "auditors", Kind: serviceApi.ProjectMemberGroup, Role: serviceApi.ProjectRoleView},
+	}
+
+	rr := newRequest(t, p)
+
+	g.Expect(configureMembers(context.Background(), rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveExactElements(
+		And(
+			jq.Match(`.metadata.name == "data-science-project-admin"`),
+			jq.Match(`.roleRef.name == "admin"`),
+			jq.Match(`.subjects == [{"apiGroup": "%s", "kind": "User", "name": "alice"}]`, rbacv1.GroupName),
+		),
+		And(
+			jq.Match(`.metadata.name == "data-science-project-edit"`),
+			jq.Match(`.roleRef.name == "edit"`),
+			jq.Match(`[.subjects[] | .kind + "/" + .name] == ["User/bob", "Group/data-scientists"]`),
+		),
+		And(
+			jq.Match(`.metadata.name == "data-science-project-view"`),
+			jq.Match(`.subjects[0].name == "auditors"`),
+		),
+	))
+}
+
+func TestConfigureQuota(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	p := newProject()
+	rr := newRequest(t, p)
+
+	g.Expect(configureQuota(ctx, rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(BeEmpty())
+
+	p.Spec.Quota = corev1.ResourceList{"requests.nvidia.com/gpu": resource.MustParse("2")}
+
+	g.Expect(configureQuota(ctx, rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveExactElements(And(
+		jq.Match(`.metadata.name == "%s"`, resourceQuotaName),
+		jq.Match(`.metadata.namespace == "%s"`, projectName),
+		jq.Match(`.spec.hard."requests.nvidia.com/gpu" == "2"`),
+	)))
+}
+
+func TestConfigureServiceMeshMember(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	p := newProject()
+	rr := newRequest(t, p)
+
+	g.Expect(configureServiceMeshMember(ctx, rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(BeEmpty())
+
+	p.Spec.ServiceMeshMember = true
+
+	g.Expect(configureServiceMeshMember(ctx, rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveExactElements(And(
+		jq.Match(`.kind == "%s"`, gvk.ServiceMeshMember.Kind),
+		jq.Match(`.metadata.name == "default"`),
+		jq.Match(`.metadata.namespace == "%s"`, projectName),
+		jq.Match(`.spec.controlPlaneRef == {"name": "data-science-smcp", "namespace": "istio-system"}`),
+	)))
+}
+
+func TestConfigureDataConnections(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copies the default and listed data connections", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p := newProject()
+		p.Spec.DataConnections = []string{"listed"}
+
+		rr := newRequest(t, p,
+			newPublishedDataConnection("shared", labels.DataConnectionDefault),
+			newPublishedDataConnection("listed", "optional"),
+			newPublishedDataConnection("unlisted", "optional"),
+		)
+
+		g.Expect(configureDataConnections(ctx, rr)).Should(Succeed())
+		g.Expect(p.Status.DataConnections).Should(ConsistOf("shared", "listed"))
+		g.Expect(rr.Resources).Should(HaveLen(2))
+		g.Expect(rr.Resources).Should(HaveEach(And(
+			jq.Match(`.metadata.namespace == "%s"`, projectName),
+			jq.Match(`.metadata.annotations."%s" == "s3"`, annotations.ConnectionType),
+			jq.Match(`.metadata.labels | has("%s") | not`, labels.DataConnection),
+		)))
+	})
+
+	t.Run("stops on a missing data connection", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p := newProject()
+		p.Spec.DataConnections = []string{"missing"}
+
+		err := configureDataConnections(ctx, newRequest(t, p))
+		g.Expect(errors.As(err, &odherrors.StopError{})).Should(BeTrue())
+		g.Expect(readyReason(p)).Should(Equal(status.DataConnectionNotFoundReason))
+	})
+
+	t.Run("deletes the copies of unpublished data connections", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p := newProject()
+		p.Status.DataConnections = []string{"shared", "revoked", "foreign"}
+
+		copied := func(name string, instance string) *corev1.Secret {
+			return &corev1.Secret{
+				TypeMeta: secretTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   projectName,
+					Annotations: map[string]string{annotations.InstanceName: instance},
+				},
+			}
+		}
+
+		rr := newRequest(t, p,
+			newPublishedDataConnection("shared", labels.DataConnectionDefault),
+			copied("shared", projectName),
+			copied("revoked", projectName),
+			copied("foreign", "other"),
+		)
+
+		g.Expect(configureDataConnections(ctx, rr)).Should(Succeed())
+		g.Expect(p.Status.DataConnections).Should(ConsistOf("shared"))
+
+		s := corev1.Secret{}
+		g.Expect(rr.Client.Get(ctx, client.ObjectKey{Namespace: projectName, Name: "shared"}, &s)).Should(Succeed())
+		g.Expect(rr.Client.Get(ctx, client.ObjectKey{Namespace: projectName, Name: "foreign"}, &s)).Should(Succeed())
+
+		err := rr.Client.Get(ctx, client.ObjectKey{Namespace: projectName, Name: "revoked"}, &s)
+		g.Expect(k8serr.IsNotFound(err)).Should(BeTrue())
+	})
+}
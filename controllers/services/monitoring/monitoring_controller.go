@@ -30,6 +30,7 @@ import (
 
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/maintenance"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/updatestatus"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
@@ -85,6 +86,7 @@ func NewServiceReconciler(ctx context.Context, mgr ctrl.Manager) error {
 			// kustomize.WithLabel(labels.ODH.Component(componentName), "true"),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, serviceName),
 		)).
+		WithAction(maintenance.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 			deploy.WithFieldOwner(serviceApi.MonitoringInstanceName),
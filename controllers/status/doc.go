@@ -25,11 +25,7 @@
 //					if err != nil {
 //						condition.Status = corev1.ConditionFalse
 //						condition.Message = err.Error()
-//						condition.Reason = status.CapabilityFailed
-//						var missingOperatorErr *feature.MissingOperatorError
-//						if errors.As(err, &missingOperatorErr) {
-//							condition.Reason = status.MissingOperatorReason
-//						}
+//						condition.Reason = feature.ConditionReason(err, status.CapabilityFailed)
 //					}
 //					conditionsv1.SetStatusCondition(&saved.Status.Conditions, *condition)
 //				}
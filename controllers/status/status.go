@@ -83,6 +83,10 @@ const (
 	RemovedReason         string = "Removed"
 	CapabilityFailed      string = "CapabilityFailed"
 	ArgoWorkflowExist     string = "ArgoWorkflowExist"
+
+	TemplateRenderFailedReason string = "TemplateRenderFailed"
+	RBACDeniedReason           string = "RBACDenied"
+	ConditionTimeoutReason     string = "ConditionTimeout"
 )
 
 const (
@@ -106,6 +110,71 @@ const (
 		"remove existing Argo workflows or set `spec.components.datasciencepipelines.managementState` to Removed to proceed"
 )
 
+const (
+	ExistingInstallationDetectedReason = "ExistingInstallationDetected"
+	InvalidPodOverridesReason          = "InvalidPodOverrides"
+)
+
+// Conditions reporting the validation of the external endpoints configured in the DSCInitialization
+// and in the cluster configuration the platform depends on.
+const (
+	EndpointServiceMeshControlPlane conditionsv1.ConditionType = "EndpointServiceMeshControlPlane"
+	EndpointCustomCABundle          conditionsv1.ConditionType = "EndpointCustomCABundle"
+	EndpointSecretStore             conditionsv1.ConditionType = "EndpointSecretStore"
+	EndpointOIDCIssuer              conditionsv1.ConditionType = "EndpointOIDCIssuer"
+	EndpointImageMirrors            conditionsv1.ConditionType = "EndpointImageMirrors"
+
+	EndpointValidReason        = "EndpointValid"
+	EndpointUnreachableReason  = "EndpointUnreachable"
+	EndpointTLSInvalidReason   = "TLSInvalid"
+	EndpointAuthRejectedReason = "AuthRejected"
+)
+
+const (
+	ConditionTypeRestarted  = "Restarted"
+	RestartInProgressReason = "RestartInProgress"
+	RestartCompletedReason  = "RestartCompleted"
+)
+
+const (
+	ConditionTypeMaintenanceWindow = "MaintenanceWindow"
+	MaintenanceWindowOpenReason    = "MaintenanceWindowOpen"
+	ChangesDeferredReason          = "ChangesDeferred"
+	InvalidMaintenanceWindowReason = "InvalidMaintenanceWindow"
+)
+
+const (
+	// UnsupportedUpgradePathReason is set on the Upgradeable condition of the DSCInitialization when the
+	// operator is upgraded across more versions than supported.
+	UnsupportedUpgradePathReason = "UnsupportedUpgradePath"
+)
+
+const (
+	// ConditionDeprecatedAPIs is set on the DSCInitialization when instances of APIs removed from the
+	// platform are still present in the cluster after an upgrade.
+	ConditionDeprecatedAPIs conditionsv1.ConditionType = "DeprecatedAPIsRemoved"
+
+	DeprecatedAPIInstancesFoundReason = "DeprecatedAPIInstancesFound"
+)
+
+const (
+	// ConditionPlatformNamespaces reports the recovery of the namespaces managed by the DSCInitialization
+	// when they are deleted out-of-band.
+	ConditionPlatformNamespaces conditionsv1.ConditionType = "PlatformNamespacesAvailable"
+
+	NamespaceTerminatingReason = "NamespaceTerminating"
+	NamespaceRecoveringReason  = "NamespaceRecovering"
+	NamespacesAvailableReason  = "NamespacesAvailable"
+)
+
+// Reasons of the Ready condition of a DataScienceProject.
+const (
+	ProjectProvisionedReason       = "ProjectProvisioned"
+	ProjectNamespaceReservedReason = "NamespaceReserved"
+	ProjectNamespaceNotOwnedReason = "NamespaceNotOwned"
+	DataConnectionNotFoundReason   = "DataConnectionNotFound"
+)
+
 // SetProgressingCondition sets the ProgressingCondition to True and other conditions to false or
 // Unknown. Used when we are just starting to reconcile, and there are no existing conditions.
 func SetProgressingCondition(conditions *[]conditionsv1.Condition, reason string, message string) {
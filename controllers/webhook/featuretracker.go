@@ -0,0 +1,120 @@
+//go:build !nowebhook
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+//+kubebuilder:webhook:path=/validate-features-opendatahub-io-v1-featuretracker,mutating=false,failurePolicy=fail,sideEffects=None,groups=features.opendatahub.io,resources=featuretrackers,verbs=update;delete,versions=v1,name=featuretracker.operator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+const (
+	serviceAccountPrefix = "system:serviceaccount:"
+	kubeSystemNamespace  = "kube-system"
+)
+
+// FeatureTrackerValidatingWebhook protects FeatureTracker resources from being modified or deleted
+// by anyone but the operator itself (and the Kubernetes controllers, e.g. the garbage collector), as
+// they are the owners of the resources created by Features and are used to clean them up.
+//
+// Cluster admins can still intervene by setting the annotations.AllowManualChanges annotation to "true"
+// on a FeatureTracker, which allows any further change to it, including its deletion.
+type FeatureTrackerValidatingWebhook struct {
+	Decoder *admission.Decoder
+	Name    string
+	// OperatorNamespace is the namespace the operator runs in, service accounts of this namespace
+	// are allowed to manage FeatureTrackers. If empty, the operator identity can't be determined and
+	// the protection is disabled, so the operator is never prevented from cleaning up its resources.
+	OperatorNamespace string
+}
+
+func (w *FeatureTrackerValidatingWebhook) SetupWithManager(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	ftWebhook := &webhook.Admission{
+		Handler:        w,
+		LogConstructor: newLogConstructor(w.Name),
+	}
+	hookServer.Register("/validate-features-opendatahub-io-v1-featuretracker", ftWebhook)
+}
+
+func (w *FeatureTrackerValidatingWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx).WithName(w.Name).WithValues("operation", req.Operation)
+
+	switch req.Operation {
+	case admissionv1.Update, admissionv1.Delete:
+	default:
+		return admission.Allowed("")
+	}
+
+	if w.isTrustedUser(req.UserInfo.Username) {
+		return admission.Allowed("")
+	}
+
+	allowed, err := w.isBreakGlass(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if allowed {
+		log.Info("FeatureTracker manually changed", "name", req.Name, "user", req.UserInfo.Username)
+		return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed by %s annotation",
+			req.Operation, req.Kind.Kind, annotations.AllowManualChanges))
+	}
+
+	return admission.Denied(fmt.Sprintf(
+		"FeatureTracker %s is managed by the operator and cannot be changed manually, "+
+			"set the %s annotation to \"true\" to override this protection",
+		req.Name, annotations.AllowManualChanges))
+}
+
+// isTrustedUser returns true for the service accounts of the operator namespace and of the kube-system
+// namespace, the latter hosting the garbage collector which is in charge of removing orphan finalizers.
+func (w *FeatureTrackerValidatingWebhook) isTrustedUser(username string) bool {
+	if w.OperatorNamespace == "" {
+		return true
+	}
+
+	sa, found := strings.CutPrefix(username, serviceAccountPrefix)
+	if !found {
+		return false
+	}
+
+	ns, _, _ := strings.Cut(sa, ":")
+
+	return ns == kubeSystemNamespace || ns == w.OperatorNamespace
+}
+
+// isBreakGlass checks the annotation on both the existing and the updated object, so that it can be
+// removed again to restore the protection.
+func (w *FeatureTrackerValidatingWebhook) isBreakGlass(req admission.Request) (bool, error) {
+	for _, raw := range []runtime.RawExtension{req.OldObject, req.Object} {
+		if len(raw.Raw) == 0 {
+			continue
+		}
+
+		tracker := featurev1.FeatureTracker{}
+		if err := w.Decoder.DecodeRaw(raw, &tracker); err != nil {
+			return false, fmt.Errorf("failed to decode FeatureTracker: %w", err)
+		}
+
+		if tracker.GetAnnotations()[annotations.AllowManualChanges] == "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,82 @@
+//go:build !nowebhook
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/acceleratordefaults"
+)
+
+//+kubebuilder:webhook:path=/mutate-kubeflow-org-v1-trainingjob,mutating=true,failurePolicy=ignore,sideEffects=None,groups=kubeflow.org,resources=jaxjobs;mpijobs;paddlejobs;pytorchjobs;tfjobs;xgboostjobs,verbs=create,versions=v1,name=trainingjob.operator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// TrainingJobDefaulter adds the default tolerations and node selector of the accelerators configured in the
+// DataScienceCluster to the jobs submitted to the Training Operator (e.g. PyTorchJob). Unlike the serving
+// runtimes, the jobs are created by the users rather than rendered by the operator, so the defaults are
+// applied on admission.
+//
+// The defaults are read from the TrainingOperator component, so jobs are left unchanged when the component
+// is not managed. The webhook is not required for the jobs to be created, so it is ignored when the operator
+// is not available.
+type TrainingJobDefaulter struct {
+	Client client.Client
+	Name   string
+}
+
+func (d *TrainingJobDefaulter) SetupWithManager(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	jobWebhook := &webhook.Admission{
+		Handler:        d,
+		LogConstructor: newLogConstructor(d.Name),
+	}
+	hookServer.Register("/mutate-kubeflow-org-v1-trainingjob", jobWebhook)
+}
+
+func (d *TrainingJobDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	component := componentApi.TrainingOperator{}
+	err := d.Client.Get(ctx, client.ObjectKey{Name: componentApi.TrainingOperatorInstanceName}, &component)
+	switch {
+	case k8serr.IsNotFound(err):
+		return admission.Allowed("")
+	case err != nil:
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	defaults := component.GetAcceleratorDefaults()
+	if len(defaults) == 0 {
+		return admission.Allowed("")
+	}
+
+	job := unstructured.Unstructured{}
+	if err := job.UnmarshalJSON(req.Object.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode %s: %w", req.Kind.Kind, err))
+	}
+
+	if err := acceleratordefaults.Apply(&job, defaults); err != nil {
+		return admission.Errored(http.StatusBadRequest,
+			fmt.Errorf("failed to apply accelerator defaults to %s %s: %w", req.Kind.Kind, req.Name, err))
+	}
+
+	raw, err := job.MarshalJSON()
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, raw)
+}
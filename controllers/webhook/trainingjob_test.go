@@ -0,0 +1,105 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newPyTorchJobRequest(t *testing.T) admission.Request {
+	t.Helper()
+
+	raw, err := json.Marshal(map[string]any{
+		"apiVersion": gvk.PyTorchJob.GroupVersion().String(),
+		"kind":       gvk.PyTorchJob.Kind,
+		"metadata":   map[string]any{"name": "fine-tuning", "namespace": "user-project"},
+		"spec": map[string]any{
+			"pytorchReplicaSpecs": map[string]any{
+				"Master": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{map[string]any{
+								"name":      "pytorch",
+								"resources": map[string]any{"limits": map[string]any{"nvidia.com/gpu": "1"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Kind:      metav1.GroupVersionKind{Group: gvk.PyTorchJob.Group, Version: gvk.PyTorchJob.Version, Kind: gvk.PyTorchJob.Kind},
+		Name:      "fine-tuning",
+		Namespace: "user-project",
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func TestTrainingJobDefaulter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	component := componentApi.TrainingOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.TrainingOperatorInstanceName},
+	}
+	component.Spec.AcceleratorDefaults = []common.AcceleratorDefaults{{
+		Identifier: "nvidia.com/gpu",
+		Tolerations: []corev1.Toleration{{
+			Key:      "nvidia.com/gpu",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+		NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+	}}
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cli.Create(ctx, &component)).Should(Succeed())
+
+	resp := (&webhook.TrainingJobDefaulter{Client: cli}).Handle(ctx, newPyTorchJobRequest(t))
+
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Patches).Should(ConsistOf(
+		And(
+			HaveField("Operation", "add"),
+			HaveField("Path", "/spec/pytorchReplicaSpecs/Master/template/spec/tolerations"),
+		),
+		And(
+			HaveField("Operation", "add"),
+			HaveField("Path", "/spec/pytorchReplicaSpecs/Master/template/spec/nodeSelector"),
+		),
+	))
+}
+
+func TestTrainingJobDefaulterComponentNotManaged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	resp := (&webhook.TrainingJobDefaulter{Client: cli}).Handle(ctx, newPyTorchJobRequest(t))
+
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Patches).Should(BeEmpty())
+}
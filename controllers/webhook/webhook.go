@@ -37,6 +37,7 @@ import (
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/modelregistry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 )
 
@@ -68,6 +69,17 @@ func Init(mgr ctrl.Manager) {
 	}).SetupWithManager(mgr)
 
 	(&DSCDefaulter{}).SetupWithManager(mgr)
+
+	(&TrainingJobDefaulter{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr)
+
+	// fallback to "" disables the protection, as the identity of the operator can't be determined
+	operatorNs, _ := cluster.GetOperatorNamespace()
+	(&FeatureTrackerValidatingWebhook{
+		Decoder:           admission.NewDecoder(mgr.GetScheme()),
+		OperatorNamespace: operatorNs,
+	}).SetupWithManager(mgr)
 }
 
 func (w *OpenDataHubValidatingWebhook) SetupWithManager(mgr ctrl.Manager) {
@@ -44,9 +44,11 @@ import (
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/services/v1alpha1"
 	modelregistry2 "github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/modelregistry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -56,6 +58,8 @@ const (
 	namespace = "webhook-test-ns"
 	nameBase  = "webhook-test"
 	mrNS      = "model-registry-namespace"
+	// the envtest client does not authenticate as a service account of the operator namespace.
+	operatorNS = "webhook-test-operator-ns"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -102,6 +106,9 @@ var _ = BeforeSuite(func() {
 	// DSC
 	err = dscv1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
+	// FeatureTracker
+	err = featurev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
 	// Webhook
 	err = admissionv1beta1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
@@ -136,6 +143,11 @@ var _ = BeforeSuite(func() {
 
 	(&webhook.DSCDefaulter{}).SetupWithManager(mgr)
 
+	(&webhook.FeatureTrackerValidatingWebhook{
+		Decoder:           admission.NewDecoder(mgr.GetScheme()),
+		OperatorNamespace: operatorNS,
+	}).SetupWithManager(mgr)
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -221,6 +233,37 @@ var _ = Describe("DSC mutating webhook", func() {
 	})
 })
 
+var _ = Describe("FeatureTracker validating webhook", func() {
+	It("Should block changes and deletion of FeatureTracker by users other than the operator", func(ctx context.Context) {
+		tracker := featurev1.NewFeatureTracker("protected-feature", namespace)
+		Expect(k8sClient.Create(ctx, tracker)).Should(Succeed())
+
+		tracker.SetLabels(map[string]string{"foo": "bar"})
+		Expect(k8sClient.Update(ctx, tracker)).ShouldNot(Succeed())
+		Expect(k8sClient.Delete(ctx, tracker)).ShouldNot(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).Should(Succeed())
+		tracker.SetAnnotations(map[string]string{annotations.AllowManualChanges: "true"})
+		Expect(k8sClient.Update(ctx, tracker)).Should(Succeed())
+		Expect(clearInstance(ctx, tracker)).Should(Succeed())
+	})
+
+	It("Should restore protection when break-glass annotation is removed", func(ctx context.Context) {
+		tracker := featurev1.NewFeatureTracker("re-protected-feature", namespace)
+		tracker.SetAnnotations(map[string]string{annotations.AllowManualChanges: "true"})
+		Expect(k8sClient.Create(ctx, tracker)).Should(Succeed())
+
+		tracker.SetAnnotations(nil)
+		Expect(k8sClient.Update(ctx, tracker)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, tracker)).ShouldNot(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).Should(Succeed())
+		tracker.SetAnnotations(map[string]string{annotations.AllowManualChanges: "true"})
+		Expect(k8sClient.Update(ctx, tracker)).Should(Succeed())
+		Expect(clearInstance(ctx, tracker)).Should(Succeed())
+	})
+})
+
 func clearInstance(ctx context.Context, instance client.Object) error {
 	return k8sClient.Delete(ctx, instance)
 }
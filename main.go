@@ -18,8 +18,12 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
+	"slices"
 
 	addonv1alpha1 "github.com/openshift/addon-operator/apis/addons/v1alpha1"
 	ocappsv1 "github.com/openshift/api/apps/v1" //nolint:importas //reason: conflicts with appsv1 "k8s.io/api/apps/v1"
@@ -44,6 +48,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -69,15 +74,24 @@ import (
 	dscctrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/datasciencecluster"
 	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/secretgenerator"
+	dspctrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/services/datascienceproject"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/setupcontroller"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conformance"
 	odhClient "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/client"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/catalog"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/certs"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/health"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/topology"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/codeflare"
@@ -138,7 +152,52 @@ func initComponents(_ context.Context, p cluster.Platform) error {
 	})
 }
 
+// verify runs the verify command, which checks the conformance of a manifests tree without a cluster and
+// prints the report as JSON. It returns the exit code of the command, non zero when the tree does not conform.
+func verify(args []string) int {
+	var manifestsPath string
+	var platform string
+
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	fs.StringVar(&manifestsPath, "manifests-path", odhdeploy.DefaultManifestPath, "The root of the manifests tree to verify.")
+	fs.StringVar(&platform, "platform", string(cluster.OpenDataHub), "The platform the manifests are selected for ("+
+		string(cluster.OpenDataHub)+", "+string(cluster.SelfManagedRhoai)+", "+string(cluster.ManagedRhoai)+").")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	platforms := []cluster.Platform{cluster.OpenDataHub, cluster.SelfManagedRhoai, cluster.ManagedRhoai}
+	if !slices.Contains(platforms, cluster.Platform(platform)) {
+		fmt.Fprintf(os.Stderr, "unsupported platform %q\n", platform)
+		return 2
+	}
+
+	report := conformance.New(
+		conformance.WithScheme(scheme),
+		conformance.WithManifestsPath(manifestsPath),
+		conformance.WithPlatform(cluster.Platform(platform)),
+	).Run()
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write the report: %v\n", err)
+		return 1
+	}
+
+	if !report.Passed {
+		return 1
+	}
+
+	return 0
+}
+
 func main() { //nolint:funlen,maintidx
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		os.Exit(verify(os.Args[2:]))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
@@ -146,6 +205,11 @@ func main() { //nolint:funlen,maintidx
 	var dscMonitoringNamespace string
 	var operatorName string
 	var logmode string
+	var platformStatusAddr string
+	var platformStatusTokenFile string
+	var serviceCatalogConsoleLinks bool
+	var deprecatedAPIsPolicy string
+	var webhookCertDir string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -158,6 +222,16 @@ func main() { //nolint:funlen,maintidx
 		"monitoring stack will be deployed")
 	flag.StringVar(&operatorName, "operator-name", "opendatahub", "The name of the operator")
 	flag.StringVar(&logmode, "log-mode", "", "Log mode ('', prod, devel), default to ''")
+	flag.StringVar(&platformStatusAddr, "platform-status-bind-address", health.DisabledBindAddress, "The address the platform status "+
+		"endpoint binds to. Set to 0 to disable it.")
+	flag.StringVar(&platformStatusTokenFile, "platform-status-token-file", "", "Path to a file containing a bearer token "+
+		"required to access the platform status endpoint. If not set, the endpoint is not authenticated.")
+	flag.BoolVar(&serviceCatalogConsoleLinks, "service-catalog-console-links", false, "Publish the endpoints of the "+
+		"platform components as links in the application menu of the OpenShift console.")
+	flag.StringVar(&deprecatedAPIsPolicy, "deprecated-apis-policy", string(upgrade.DeprecatedAPIPolicyReport), "How the instances "+
+		"of the APIs removed from the platform are handled on upgrade (Report, Cleanup).")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", certs.DefaultCertDir, "The directory the serving certificate of "+
+		"the webhook server is mounted to, it is reloaded when rotated.")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
@@ -166,6 +240,12 @@ func main() { //nolint:funlen,maintidx
 
 	ctrl.SetLogger(logger.NewLogger(logmode, &opts))
 
+	deprecatedAPIs, err := upgrade.ParseDeprecatedAPIPolicy(deprecatedAPIsPolicy)
+	if err != nil {
+		setupLog.Error(err, "invalid deprecated-apis-policy flag")
+		os.Exit(1)
+	}
+
 	// root context
 	ctx := ctrl.SetupSignalHandler()
 	ctx = logf.IntoContext(ctx, setupLog)
@@ -191,6 +271,12 @@ func main() { //nolint:funlen,maintidx
 		os.Exit(1)
 	}
 
+	// The templates embedded for each flavor are checked before any of them is used
+	if err := overlays.ValidateAll(); err != nil {
+		setupLog.Error(err, "unable to load the embedded templates")
+		os.Exit(1)
+	}
+
 	// Get operator platform
 	release := cluster.GetRelease()
 	platform := release.Name
@@ -200,6 +286,14 @@ func main() { //nolint:funlen,maintidx
 		os.Exit(1)
 	}
 
+	// the operator namespace is not known when running out of the cluster, the CA bundle is then left as is
+	operatorNamespace, _ := cluster.GetOperatorNamespace()
+	webhookCerts := certs.New(
+		setupClient,
+		certs.WithCertDir(webhookCertDir),
+		certs.WithWebhookService(operatorNamespace, certs.DefaultWebhookServiceName),
+	)
+
 	secretCache := createSecretCacheConfig(platform)
 	deploymentCache := createDeploymentCacheConfig(platform)
 	cacheOptions := cache.Options{
@@ -240,8 +334,9 @@ func main() { //nolint:funlen,maintidx
 		Scheme:  scheme,
 		Metrics: ctrlmetrics.Options{BindAddress: metricsAddr},
 		WebhookServer: ctrlwebhook.NewServer(ctrlwebhook.Options{
-			Port: 9443,
-			// TLSOpts: , // TODO: it was not set in the old code
+			Port:    9443,
+			CertDir: webhookCertDir,
+			TLSOpts: []func(*tls.Config){webhookCerts.TLSOpts},
 		}),
 		HealthProbeBindAddress: probeAddr,
 		Cache:                  cacheOptions,
@@ -325,6 +420,11 @@ func main() { //nolint:funlen,maintidx
 		os.Exit(1)
 	}
 
+	if err = dspctrl.NewServiceReconciler(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DataScienceProject")
+		os.Exit(1)
+	}
+
 	ons, err := cluster.GetOperatorNamespace()
 	if err != nil {
 		setupLog.Error(err, "unable to determine Operator Namespace")
@@ -343,11 +443,56 @@ func main() { //nolint:funlen,maintidx
 		os.Exit(1)
 	}
 
+	if err := mgr.Add(webhookCerts); err != nil {
+		setupLog.Error(err, "unable to register webhook certificate service")
+		os.Exit(1)
+	}
+
+	platformStatusToken := ""
+	if platformStatusTokenFile != "" {
+		token, err := os.ReadFile(platformStatusTokenFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read platform status token")
+			os.Exit(1)
+		}
+		platformStatusToken = string(token)
+	}
+
+	err = mgr.Add(health.New(
+		mgr.GetClient(),
+		health.WithBindAddress(platformStatusAddr),
+		health.WithBearerToken(platformStatusToken),
+	))
+	if err != nil {
+		setupLog.Error(err, "unable to register platform status service")
+		os.Exit(1)
+	}
+
+	if err := mgr.Add(topology.New(mgr.GetClient(), topology.WithAPIReader(mgr.GetAPIReader()))); err != nil {
+		setupLog.Error(err, "unable to register platform topology exporter")
+		os.Exit(1)
+	}
+
+	if err := mgr.Add(catalog.New(
+		mgr.GetClient(),
+		catalog.WithAPIReader(mgr.GetAPIReader()),
+		catalog.WithConsoleLinks(serviceCatalogConsoleLinks),
+	)); err != nil {
+		setupLog.Error(err, "unable to register platform service catalog exporter")
+		os.Exit(1)
+	}
+
 	// Initialize component reconcilers
 	if err = CreateComponentReconcilers(ctx, mgr); err != nil {
 		os.Exit(1)
 	}
 
+	// refuse to upgrade across unsupported versions before anything is migrated
+	if err := upgrade.EnforceVersionSkew(ctx, setupClient, release); err != nil {
+		setupLog.Error(err, "unable to upgrade from the deployed release")
+		os.Exit(1)
+	}
+
 	// get old release version before we create default DSCI CR
 	oldReleaseVersion, _ := upgrade.GetDeployedRelease(ctx, setupClient)
 
@@ -398,6 +543,20 @@ func main() { //nolint:funlen,maintidx
 		setupLog.Error(err, "error remove deprecated resources from previous version")
 	}
 
+	// Report, and clean up if opted in, the instances of the APIs removed from the platform
+	var deprecatedAPIsFunc manager.RunnableFunc = func(ctx context.Context) error {
+		if err := upgrade.HandleDeprecatedAPIs(ctx, setupClient, release, deprecatedAPIs, upgrade.DeprecatedAPIs); err != nil {
+			setupLog.Error(err, "unable to handle the instances of removed APIs")
+		}
+		// not fatal, the condition of the DSCInitialization tells what is left
+		return nil
+	}
+
+	err = mgr.Add(deprecatedAPIsFunc)
+	if err != nil {
+		setupLog.Error(err, "error scheduling the handling of removed APIs")
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -422,6 +581,12 @@ func createSecretCacheConfig(platform cluster.Platform) map[string]cache.Config
 	namespaceConfigs := map[string]cache.Config{
 		"istio-system":      {}, // for both knative-serving-cert and default-modelregistry-cert,as an easy workarond, to watch all in this namespace for now
 		"openshift-ingress": {},
+		// the data connections copied to the DataScienceProjects, in namespaces not known upfront
+		cache.AllNamespaces: {
+			LabelSelector: k8slabels.SelectorFromSet(k8slabels.Set{
+				labels.PlatformPartOf: serviceApi.DataScienceProjectServiceName,
+			}),
+		},
 	}
 	switch platform {
 	case cluster.ManagedRhoai:
@@ -35,6 +35,7 @@ type Release struct {
 var clusterConfig struct {
 	Namespace string
 	Release   Release
+	Flavor    Flavor
 }
 
 // Init initializes cluster configuration variables on startup
@@ -54,6 +55,11 @@ func Init(ctx context.Context, cli client.Client) error {
 		return err
 	}
 
+	clusterConfig.Flavor, err = getFlavor(ctx, cli)
+	if err != nil {
+		return err
+	}
+
 	printClusterConfig(log)
 
 	return nil
@@ -62,7 +68,8 @@ func Init(ctx context.Context, cli client.Client) error {
 func printClusterConfig(log logr.Logger) {
 	log.Info("Cluster config",
 		"Namespace", clusterConfig.Namespace,
-		"Release", clusterConfig.Release)
+		"Release", clusterConfig.Release,
+		"Flavor", clusterConfig.Flavor)
 }
 
 func GetOperatorNamespace() (string, error) {
@@ -15,4 +15,10 @@ const (
 
 	// Default cluster-scope Authentication CR name.
 	ClusterAuthenticationObj = "cluster"
+
+	// Default cluster-scope Image CR name.
+	ClusterImageObj = "cluster"
+
+	// OpenshiftConfigNamespace defines the namespace of the user provided configuration of the cluster.
+	OpenshiftConfigNamespace = "openshift-config"
 )
@@ -0,0 +1,108 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Flavor is the kind of Kubernetes distribution the operator runs on, which determines the overlay of
+// the embedded templates that is used.
+type Flavor string
+
+const (
+	// OpenShift is a standalone OpenShift cluster.
+	OpenShift Flavor = "openshift"
+	// OpenShiftFIPS is a standalone OpenShift cluster installed in FIPS mode.
+	OpenShiftFIPS Flavor = "openshift-fips"
+	// HostedControlPlane is an OpenShift cluster whose control plane runs outside of the cluster (HCP).
+	HostedControlPlane Flavor = "hcp"
+	// Kubernetes is a Kubernetes distribution other than OpenShift.
+	Kubernetes Flavor = "kubernetes"
+)
+
+// Flavors lists all the supported flavors.
+var Flavors = []Flavor{OpenShift, OpenShiftFIPS, HostedControlPlane, Kubernetes}
+
+const (
+	clusterInfrastructureObj = "cluster"
+	installConfigNamespace   = "kube-system"
+	installConfigName        = "cluster-config-v1"
+	installConfigKey         = "install-config"
+)
+
+// GetFlavor returns the flavor of the cluster detected on startup.
+func GetFlavor() Flavor {
+	return clusterConfig.Flavor
+}
+
+func getFlavor(ctx context.Context, cli client.Client) (Flavor, error) {
+	if flavor := Flavor(os.Getenv("ODH_PLATFORM_FLAVOR")); flavor != "" {
+		if !slices.Contains(Flavors, flavor) {
+			return "", fmt.Errorf("unsupported platform flavor %q", flavor)
+		}
+
+		return flavor, nil
+	}
+
+	return detectFlavor(ctx, cli)
+}
+
+func detectFlavor(ctx context.Context, cli client.Client) (Flavor, error) {
+	infra := configv1.Infrastructure{}
+	err := cli.Get(ctx, client.ObjectKey{Name: clusterInfrastructureObj}, &infra)
+	switch {
+	case meta.IsNoMatchError(err) || k8serr.IsNotFound(err):
+		return Kubernetes, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get cluster infrastructure: %w", err)
+	}
+
+	// the control plane of a hosted cluster is not reachable, so it can not be configured for FIPS
+	// from the cluster itself
+	if infra.Status.ControlPlaneTopology == configv1.ExternalTopologyMode {
+		return HostedControlPlane, nil
+	}
+
+	fips, err := isFIPSEnabled(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if fips {
+		return OpenShiftFIPS, nil
+	}
+
+	return OpenShift, nil
+}
+
+// isFIPSEnabled returns true if the cluster has been installed in FIPS mode, according to its install
+// config.
+func isFIPSEnabled(ctx context.Context, cli client.Client) (bool, error) {
+	cm := corev1.ConfigMap{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: installConfigNamespace, Name: installConfigName}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get install config: %w", err)
+	}
+
+	installConfig := struct {
+		FIPS bool `json:"fips"`
+	}{}
+
+	if err := yaml.Unmarshal([]byte(cm.Data[installConfigKey]), &installConfig); err != nil {
+		return false, fmt.Errorf("failed to parse install config: %w", err)
+	}
+
+	return installConfig.FIPS, nil
+}
@@ -0,0 +1,91 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDetectFlavor(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(configv1.Install(scheme))
+
+	infrastructure := func(topology configv1.TopologyMode) *configv1.Infrastructure {
+		return &configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterInfrastructureObj},
+			Status:     configv1.InfrastructureStatus{ControlPlaneTopology: topology},
+		}
+	}
+
+	installConfig := func(content string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: installConfigNamespace, Name: installConfigName},
+			Data:       map[string]string{installConfigKey: content},
+		}
+	}
+
+	tests := map[string]struct {
+		objs   []client.Object
+		flavor Flavor
+	}{
+		"kubernetes": {
+			flavor: Kubernetes,
+		},
+		"openshift": {
+			objs:   []client.Object{infrastructure(configv1.HighlyAvailableTopologyMode), installConfig("apiVersion: v1\nfips: false\n")},
+			flavor: OpenShift,
+		},
+		"openshift without install config": {
+			objs:   []client.Object{infrastructure(configv1.SingleReplicaTopologyMode)},
+			flavor: OpenShift,
+		},
+		"openshift in fips mode": {
+			objs:   []client.Object{infrastructure(configv1.HighlyAvailableTopologyMode), installConfig("apiVersion: v1\nfips: true\n")},
+			flavor: OpenShiftFIPS,
+		},
+		"hosted control plane": {
+			objs:   []client.Object{infrastructure(configv1.ExternalTopologyMode)},
+			flavor: HostedControlPlane,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).Build()
+
+			flavor, err := detectFlavor(ctx, cli)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(flavor).Should(Equal(tt.flavor))
+		})
+	}
+}
+
+func TestGetFlavorOverride(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	t.Setenv("ODH_PLATFORM_FLAVOR", string(HostedControlPlane))
+
+	flavor, err := getFlavor(ctx, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(flavor).Should(Equal(HostedControlPlane))
+
+	t.Setenv("ODH_PLATFORM_FLAVOR", "openshift-arm")
+
+	_, err = getFlavor(ctx, nil)
+	g.Expect(err).Should(HaveOccurred())
+}
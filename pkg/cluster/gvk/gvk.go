@@ -34,6 +34,12 @@ var (
 		Kind:    "Deployment",
 	}
 
+	StatefulSet = schema.GroupVersionKind{
+		Group:   appsv1.SchemeGroupVersion.Group,
+		Version: appsv1.SchemeGroupVersion.Version,
+		Kind:    "StatefulSet",
+	}
+
 	ClusterRole = schema.GroupVersionKind{
 		Group:   "rbac.authorization.k8s.io",
 		Version: "v1",
@@ -58,6 +64,12 @@ var (
 		Kind:    "ConfigMap",
 	}
 
+	Namespace = schema.GroupVersionKind{
+		Group:   corev1.SchemeGroupVersion.Group,
+		Version: corev1.SchemeGroupVersion.Version,
+		Kind:    "Namespace",
+	}
+
 	KnativeServing = schema.GroupVersionKind{
 		Group:   "operator.knative.dev",
 		Version: "v1beta1",
@@ -70,6 +82,30 @@ var (
 		Kind:    "Ingress",
 	}
 
+	OpenshiftAuthentication = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Authentication",
+	}
+
+	OpenshiftImage = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Image",
+	}
+
+	ImageDigestMirrorSet = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "ImageDigestMirrorSet",
+	}
+
+	ImageTagMirrorSet = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "ImageTagMirrorSet",
+	}
+
 	ServiceMeshControlPlane = schema.GroupVersionKind{
 		Group:   "maistra.io",
 		Version: "v2",
@@ -213,4 +249,76 @@ var (
 		Version: "v1beta1",
 		Kind:    "Gateway",
 	}
+
+	ExternalSecret = schema.GroupVersionKind{
+		Group:   "external-secrets.io",
+		Version: "v1beta1",
+		Kind:    "ExternalSecret",
+	}
+
+	SecretStore = schema.GroupVersionKind{
+		Group:   "external-secrets.io",
+		Version: "v1beta1",
+		Kind:    "SecretStore",
+	}
+
+	ClusterSecretStore = schema.GroupVersionKind{
+		Group:   "external-secrets.io",
+		Version: "v1beta1",
+		Kind:    "ClusterSecretStore",
+	}
+
+	InferenceService = schema.GroupVersionKind{
+		Group:   "serving.kserve.io",
+		Version: "v1beta1",
+		Kind:    "InferenceService",
+	}
+
+	ServingRuntime = schema.GroupVersionKind{
+		Group:   "serving.kserve.io",
+		Version: "v1alpha1",
+		Kind:    "ServingRuntime",
+	}
+
+	OpenshiftTemplate = schema.GroupVersionKind{
+		Group:   "template.openshift.io",
+		Version: "v1",
+		Kind:    "Template",
+	}
+
+	PyTorchJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "PyTorchJob",
+	}
+
+	TFJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "TFJob",
+	}
+
+	MPIJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "MPIJob",
+	}
+
+	XGBoostJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "XGBoostJob",
+	}
+
+	PaddleJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "PaddleJob",
+	}
+
+	JAXJob = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    "JAXJob",
+	}
 )
@@ -13,6 +13,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
 // ComponentHandler is an interface to manage a component
@@ -32,6 +33,14 @@ type ComponentHandler interface {
 	UpdateDSCStatus(dsc *dscv1.DataScienceCluster, obj client.Object) error
 }
 
+// ManifestsProvider is implemented by the components deploying manifests from the manifests tree of the
+// operator, so that the tree can be verified without a cluster.
+type ManifestsProvider interface {
+	// Manifests returns the manifests deployed by default on the given platform, rooted at the
+	// default manifests path.
+	Manifests(platform cluster.Platform) []odhtypes.ManifestInfo
+}
+
 var registry = []ComponentHandler{}
 
 // Add registers a new component handler
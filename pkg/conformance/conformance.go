@@ -0,0 +1,242 @@
+// Package conformance verifies a manifests tree against the operator without a cluster, so that the
+// downstream forks of the manifests can check that the operator still deploys them: the embedded
+// templates are executed with representative data, the manifests of each component are rendered as on reconciliation, and the
+// rendered resources known to the operator are validated against their schema.
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
+)
+
+const (
+	// CheckTemplates executes the templates embedded in the operator with representative data, for all the
+	// flavors.
+	CheckTemplates = "templates"
+	// CheckRender renders the manifests of a component with kustomize.
+	CheckRender = "render"
+	// CheckSchema validates the rendered resources of a component against the types of the operator.
+	CheckSchema = "schema"
+)
+
+type Status string
+
+const (
+	StatusPassed Status = "Passed"
+	StatusFailed Status = "Failed"
+)
+
+// Check is the result of one verification.
+type Check struct {
+	Name      string `json:"name"`
+	Component string `json:"component,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Status    Status `json:"status"`
+	Message   string `json:"message,omitempty"`
+	// Resources is the number of resources rendered, or validated for a schema check.
+	Resources int `json:"resources,omitempty"`
+	// Skipped is the number of resources whose kind is unknown to the operator, which are not validated.
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// Report is the conformance report of a manifests tree, it passes when all its checks pass.
+type Report struct {
+	Platform      cluster.Platform `json:"platform"`
+	ManifestsPath string           `json:"manifestsPath"`
+	Passed        bool             `json:"passed"`
+	Checks        []Check          `json:"checks"`
+}
+
+func (r *Report) add(c Check) {
+	r.Checks = append(r.Checks, c)
+	r.Passed = r.Passed && c.Status == StatusPassed
+}
+
+type OptsFn func(*Verifier)
+
+// WithScheme sets the scheme the rendered resources are validated against, the resources of kinds it does
+// not know are skipped.
+func WithScheme(s *runtime.Scheme) OptsFn {
+	return func(v *Verifier) {
+		v.scheme = s
+	}
+}
+
+// WithManifestsPath sets the root of the manifests tree to verify, defaults to the one of the operator.
+func WithManifestsPath(p string) OptsFn {
+	return func(v *Verifier) {
+		v.manifestsPath = p
+	}
+}
+
+// WithPlatform sets the platform the manifests are selected for.
+func WithPlatform(p cluster.Platform) OptsFn {
+	return func(v *Verifier) {
+		v.platform = p
+	}
+}
+
+// WithTemplates sets the file systems whose templates are verified, defaults to all the ones of the operator.
+func WithTemplates(values ...*overlays.FS) OptsFn {
+	return func(v *Verifier) {
+		v.templates = values
+	}
+}
+
+// WithComponents sets the components whose manifests are verified, defaults to all the registered ones.
+func WithComponents(values ...cr.ComponentHandler) OptsFn {
+	return func(v *Verifier) {
+		v.components = values
+	}
+}
+
+// Verifier runs the checks of the conformance report.
+type Verifier struct {
+	scheme        *runtime.Scheme
+	manifestsPath string
+	platform      cluster.Platform
+	components    []cr.ComponentHandler
+	templates     []*overlays.FS
+	engine        *kustomize.Engine
+}
+
+func New(opts ...OptsFn) *Verifier {
+	v := Verifier{
+		scheme:        runtime.NewScheme(),
+		manifestsPath: odhdeploy.DefaultManifestPath,
+		platform:      cluster.OpenDataHub,
+		templates:     overlays.All(),
+		engine:        kustomize.NewEngine(),
+	}
+
+	_ = cr.ForEach(func(ch cr.ComponentHandler) error {
+		v.components = append(v.components, ch)
+		return nil
+	})
+
+	for _, o := range opts {
+		o(&v)
+	}
+
+	return &v
+}
+
+// Run verifies the manifests tree and returns the report, the failures are reported as checks rather
+// than returned.
+func (v *Verifier) Run() *Report {
+	r := Report{
+		Platform:      v.platform,
+		ManifestsPath: v.manifestsPath,
+		Passed:        true,
+	}
+
+	r.add(result(Check{Name: CheckTemplates}, v.execute()))
+
+	for _, ch := range v.components {
+		mp, ok := ch.(cr.ManifestsProvider)
+		if !ok {
+			continue
+		}
+
+		for _, mi := range mp.Manifests(v.platform) {
+			v.verify(&r, ch.GetName(), v.rebase(mi))
+		}
+	}
+
+	return &r
+}
+
+// execute validates the layout of the templates and executes them, on each flavor, with the data they are
+// rendered with on reconciliation, so that a template referring to data the operator does not provide fails
+// (i.e. missing keys are errors) rather than rendering an empty value.
+func (v *Verifier) execute() error {
+	errs := make([]error, 0, len(v.templates))
+	for _, t := range v.templates {
+		if err := errors.Join(t.Validate(), t.Execute()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (v *Verifier) verify(r *Report, component string, path string) {
+	resources, err := v.engine.Render(path)
+	r.add(result(Check{Name: CheckRender, Component: component, Path: path, Resources: len(resources)}, err))
+
+	if err != nil {
+		return
+	}
+
+	c := Check{Name: CheckSchema, Component: component, Path: path}
+
+	var errs []error
+	for i := range resources {
+		validated, err := v.validate(&resources[i])
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if validated {
+			c.Resources++
+		} else {
+			c.Skipped++
+		}
+	}
+
+	r.add(result(c, errors.Join(errs...)))
+}
+
+// validate checks the identity of the resource, and its fields when its kind is known to the scheme. It
+// returns whether the fields were validated.
+func (v *Verifier) validate(u *unstructured.Unstructured) (bool, error) {
+	gvk := u.GroupVersionKind()
+	if gvk.Version == "" || gvk.Kind == "" || u.GetName() == "" {
+		return false, fmt.Errorf("resource %q of kind %q has no apiVersion, kind or name", u.GetName(), gvk.Kind)
+	}
+
+	if !v.scheme.Recognizes(gvk) {
+		return false, nil
+	}
+
+	obj, err := v.scheme.New(gvk)
+	if err != nil {
+		return false, err
+	}
+
+	// unknown fields are errors, as they would be silently dropped when the resource is deployed
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(u.Object, obj, true); err != nil {
+		return true, fmt.Errorf("%s %s: %w", gvk.Kind, u.GetName(), err)
+	}
+
+	return true, nil
+}
+
+// rebase moves the manifests from the default manifests path of the operator to the verified tree.
+func (v *Verifier) rebase(mi odhtypes.ManifestInfo) string {
+	mi.Path = filepath.Join(v.manifestsPath, strings.TrimPrefix(mi.Path, odhdeploy.DefaultManifestPath))
+
+	return mi.String()
+}
+
+func result(c Check, err error) Check {
+	c.Status = StatusPassed
+	if err != nil {
+		c.Status = StatusFailed
+		c.Message = err.Error()
+	}
+
+	return c
+}
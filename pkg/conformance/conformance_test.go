@@ -0,0 +1,195 @@
+package conformance_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/componentsregistry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conformance"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
+
+	. "github.com/onsi/gomega"
+)
+
+const kustomization = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+resources:
+- resources.yaml
+`
+
+const validResources = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  foo: bar
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+spec:
+  anything: goes
+`
+
+const invalidResources = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller
+spec:
+  replica: 1
+`
+
+const smcpTemplate = `
+apiVersion: maistra.io/v2
+kind: ServiceMeshControlPlane
+metadata:
+  name: {{ .ControlPlane.Name }}
+  namespace: {{ .ControlPlane.Namespace }}
+`
+
+func templatesData() (any, error) {
+	return map[string]any{
+		"ControlPlane": map[string]any{"Name": "data-science-smcp", "Namespace": "istio-system"},
+	}, nil
+}
+
+// component only implements the methods used by the verification.
+type component struct {
+	cr.ComponentHandler
+
+	name      string
+	manifests []odhtypes.ManifestInfo
+}
+
+func (c *component) GetName() string {
+	return c.name
+}
+
+func (c *component) Manifests(_ cluster.Platform) []odhtypes.ManifestInfo {
+	return c.manifests
+}
+
+func writeManifests(t *testing.T, root string, dir string, resources string) {
+	t.Helper()
+	g := NewWithT(t)
+
+	g.Expect(os.MkdirAll(filepath.Join(root, dir), 0o755)).Should(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(root, dir, "kustomization.yaml"), []byte(kustomization), 0o600)).Should(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(root, dir, "resources.yaml"), []byte(resources), 0o600)).Should(Succeed())
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+	root := t.TempDir()
+
+	writeManifests(t, root, "valid/base", validResources)
+	writeManifests(t, root, "invalid/base", invalidResources)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+
+	templates := fstest.MapFS{
+		"resources/base/servicemesh/smcp.tmpl.yaml": {Data: []byte(smcpTemplate)},
+	}
+
+	v := conformance.New(
+		conformance.WithScheme(scheme),
+		conformance.WithManifestsPath(root),
+		conformance.WithTemplates(overlays.New(templates, "resources", overlays.WithData(templatesData))),
+		conformance.WithComponents(
+			&component{name: "valid", manifests: []odhtypes.ManifestInfo{
+				{Path: odhdeploy.DefaultManifestPath, ContextDir: "valid", SourcePath: "base"},
+			}},
+			&component{name: "invalid", manifests: []odhtypes.ManifestInfo{
+				{Path: odhdeploy.DefaultManifestPath, ContextDir: "invalid", SourcePath: "base"},
+				{Path: odhdeploy.DefaultManifestPath, ContextDir: "missing"},
+			}},
+		),
+	)
+
+	r := v.Run()
+
+	g.Expect(r.Passed).Should(BeFalse())
+	g.Expect(r.Platform).Should(Equal(cluster.OpenDataHub))
+	g.Expect(r.Checks).Should(HaveExactElements(
+		And(
+			HaveField("Name", conformance.CheckTemplates),
+			HaveField("Status", conformance.StatusPassed),
+		),
+		And(
+			HaveField("Name", conformance.CheckRender),
+			HaveField("Component", "valid"),
+			HaveField("Path", filepath.Join(root, "valid/base")),
+			HaveField("Status", conformance.StatusPassed),
+			HaveField("Resources", 2),
+		),
+		And(
+			HaveField("Name", conformance.CheckSchema),
+			HaveField("Component", "valid"),
+			HaveField("Status", conformance.StatusPassed),
+			HaveField("Resources", 1),
+			HaveField("Skipped", 1),
+		),
+		And(
+			HaveField("Name", conformance.CheckRender),
+			HaveField("Component", "invalid"),
+			HaveField("Status", conformance.StatusPassed),
+		),
+		And(
+			HaveField("Name", conformance.CheckSchema),
+			HaveField("Component", "invalid"),
+			HaveField("Status", conformance.StatusFailed),
+			HaveField("Message", ContainSubstring(`unknown field "spec.replica"`)),
+		),
+		And(
+			HaveField("Name", conformance.CheckRender),
+			HaveField("Component", "invalid"),
+			HaveField("Path", filepath.Join(root, "missing")),
+			HaveField("Status", conformance.StatusFailed),
+		),
+	))
+}
+
+func TestRunTemplateMissingKey(t *testing.T) {
+	g := NewWithT(t)
+
+	// the overlay of a fork refers to data the operator does not provide
+	templates := fstest.MapFS{
+		"resources/base/servicemesh/smcp.tmpl.yaml": {Data: []byte(smcpTemplate)},
+		"resources/overlays/openshift-fips/servicemesh/smcp.tmpl.yaml": {
+			Data: []byte(smcpTemplate + "spec:\n  version: {{ .ControlPlane.Version }}\n"),
+		},
+	}
+
+	v := conformance.New(
+		conformance.WithManifestsPath(t.TempDir()),
+		conformance.WithTemplates(overlays.New(templates, "resources", overlays.WithData(templatesData))),
+		conformance.WithComponents(),
+	)
+
+	r := v.Run()
+
+	g.Expect(r.Passed).Should(BeFalse())
+	g.Expect(r.Checks).Should(HaveExactElements(
+		And(
+			HaveField("Name", conformance.CheckTemplates),
+			HaveField("Status", conformance.StatusFailed),
+			HaveField("Message", ContainSubstring("flavor openshift-fips")),
+			HaveField("Message", ContainSubstring(`map has no entry for key "Version"`)),
+		),
+	))
+}
@@ -0,0 +1,270 @@
+package acceleratordefaults
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// Action adds the default tolerations and node selector of the accelerators configured on a component
+// to the rendered ServingRuntimes, including the ones shipped as OpenShift Templates. The defaults of an
+// accelerator are applied to a pod spec when one of its containers requests the accelerator resource, or
+// when the workload lists the accelerator in the annotations.RecommendedAccelerators annotation.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct{}
+
+// trainingJobReplicaSpecs maps the kinds of the jobs of the Training Operator to the field holding the
+// pod templates of their replicas.
+var trainingJobReplicaSpecs = map[schema.GroupKind]string{
+	gvk.PyTorchJob.GroupKind(): "pytorchReplicaSpecs",
+	gvk.TFJob.GroupKind():      "tfReplicaSpecs",
+	gvk.MPIJob.GroupKind():     "mpiReplicaSpecs",
+	gvk.XGBoostJob.GroupKind(): "xgbReplicaSpecs",
+	gvk.PaddleJob.GroupKind():  "paddleReplicaSpecs",
+	gvk.JAXJob.GroupKind():     "jaxReplicaSpecs",
+}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(_ context.Context, rr *types.ReconciliationRequest) error {
+	inst, ok := rr.Instance.(common.WithAcceleratorDefaults)
+	if !ok || len(inst.GetAcceleratorDefaults()) == 0 {
+		return nil
+	}
+
+	defaults := inst.GetAcceleratorDefaults()
+
+	for i := range rr.Resources {
+		if err := apply(rr.Resources[i].Object, defaults); err != nil {
+			return fmt.Errorf("failed to apply accelerator defaults to %s %s: %w",
+				rr.Resources[i].GetKind(), rr.Resources[i].GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// Apply adds the defaults of the accelerators to the pod specs of the given workload, i.e. a ServingRuntime,
+// an OpenShift Template embedding ServingRuntimes, or a job of the Training Operator. Other kinds are left
+// unchanged.
+func Apply(obj *unstructured.Unstructured, defaults []common.AcceleratorDefaults) error {
+	return apply(obj.Object, defaults)
+}
+
+// apply modifies the object in place, the pod specs are not copied so that the objects embedded in
+// a Template are updated as well.
+func apply(obj map[string]any, defaults []common.AcceleratorDefaults) error {
+	u := unstructured.Unstructured{Object: obj}
+	gk := u.GroupVersionKind().GroupKind()
+
+	if field, ok := trainingJobReplicaSpecs[gk]; ok {
+		replicas, err := nestedMap(obj, "spec", field)
+		if err != nil {
+			return err
+		}
+
+		specs := make([]map[string]any, 0, len(replicas))
+		for name := range replicas {
+			replica, ok := replicas[name].(map[string]any)
+			if !ok {
+				return fmt.Errorf("field %s.%s is not a map", field, name)
+			}
+
+			spec, err := nestedMap(replica, "template", "spec")
+			if err != nil {
+				return err
+			}
+			if spec != nil {
+				specs = append(specs, spec)
+			}
+		}
+
+		return applyToPodSpecs(&u, specs, defaults)
+	}
+
+	switch gk {
+	case gvk.OpenshiftTemplate.GroupKind():
+		objects, err := nestedMaps(obj, "objects")
+		if err != nil {
+			return err
+		}
+
+		for _, o := range objects {
+			if err := apply(o, defaults); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case gvk.ServingRuntime.GroupKind():
+		spec, err := nestedMap(obj, "spec")
+		if err != nil || spec == nil {
+			return err
+		}
+
+		return applyToPodSpecs(&u, []map[string]any{spec}, defaults)
+	default:
+		return nil
+	}
+}
+
+func applyToPodSpecs(obj *unstructured.Unstructured, specs []map[string]any, defaults []common.AcceleratorDefaults) error {
+	recommended := []string{}
+	if v, ok := obj.GetAnnotations()[annotations.RecommendedAccelerators]; ok {
+		if err := json.Unmarshal([]byte(v), &recommended); err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", annotations.RecommendedAccelerators, err)
+		}
+	}
+
+	for _, spec := range specs {
+		for i := range defaults {
+			uses, err := requests(spec, defaults[i].Identifier)
+			if err != nil {
+				return err
+			}
+
+			if !uses && !slices.Contains(recommended, defaults[i].Identifier) {
+				continue
+			}
+
+			if err := applyToPodSpec(spec, &defaults[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// requests returns whether a container of the pod spec requests, or limits, the given resource.
+func requests(spec map[string]any, resource string) (bool, error) {
+	containers, err := nestedMaps(spec, "containers")
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range containers {
+		for _, field := range []string{"limits", "requests"} {
+			quantities, err := nestedMap(c, "resources", field)
+			if err != nil {
+				return false, err
+			}
+
+			if _, ok := quantities[resource]; ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func applyToPodSpec(spec map[string]any, d *common.AcceleratorDefaults) error {
+	tolerations, _, err := unstructured.NestedSlice(spec, "tolerations")
+	if err != nil {
+		return err
+	}
+
+	for i := range d.Tolerations {
+		t, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&d.Tolerations[i])
+		if err != nil {
+			return fmt.Errorf("failed to convert toleration to unstructured: %w", err)
+		}
+
+		found := slices.ContainsFunc(tolerations, func(existing any) bool {
+			return equality.Semantic.DeepEqual(existing, any(t))
+		})
+		if !found {
+			tolerations = append(tolerations, t)
+		}
+	}
+
+	if len(tolerations) != 0 {
+		if err := unstructured.SetNestedSlice(spec, tolerations, "tolerations"); err != nil {
+			return err
+		}
+	}
+
+	if len(d.NodeSelector) == 0 {
+		return nil
+	}
+
+	selector, _, err := unstructured.NestedStringMap(spec, "nodeSelector")
+	if err != nil {
+		return err
+	}
+
+	if selector == nil {
+		selector = make(map[string]string, len(d.NodeSelector))
+	}
+
+	for k, v := range d.NodeSelector {
+		if _, ok := selector[k]; !ok {
+			selector[k] = v
+		}
+	}
+
+	return unstructured.SetNestedStringMap(spec, selector, "nodeSelector")
+}
+
+func nestedMap(obj map[string]any, fields ...string) (map[string]any, error) {
+	v, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found || v == nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("field %v is not a map", fields)
+	}
+
+	return m, nil
+}
+
+func nestedMaps(obj map[string]any, fields ...string) ([]map[string]any, error) {
+	v, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found || v == nil {
+		return nil, err
+	}
+
+	items, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("field %v is not a slice", fields)
+	}
+
+	result := make([]map[string]any, 0, len(items))
+	for i := range items {
+		m, ok := items[i].(map[string]any)
+		if !ok {
+			return nil, errors.New("field is not a map")
+		}
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
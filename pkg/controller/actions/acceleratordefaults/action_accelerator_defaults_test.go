@@ -0,0 +1,294 @@
+package acceleratordefaults_test
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/acceleratordefaults"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	mk "github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+var gpuDefaults = common.AcceleratorDefaults{
+	Identifier: "nvidia.com/gpu",
+	Tolerations: []corev1.Toleration{{
+		Key:      "nvidia.com/gpu",
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoSchedule,
+	}},
+	NodeSelector: map[string]string{
+		"node.kubernetes.io/instance-type": "g5.2xlarge",
+		"nvidia.com/gpu.present":           "true",
+	},
+}
+
+func newServingRuntime(name string, podSpec map[string]any) map[string]any {
+	podSpec["containers"] = []any{map[string]any{"name": "kserve-container"}}
+
+	return map[string]any{
+		"apiVersion": gvk.ServingRuntime.GroupVersion().String(),
+		"kind":       gvk.ServingRuntime.Kind,
+		"metadata":   map[string]any{"name": name},
+		"spec":       podSpec,
+	}
+}
+
+func withGPU(sr map[string]any) map[string]any {
+	containers, _, _ := unstructured.NestedSlice(sr, "spec", "containers")
+	containers[0].(map[string]any)["resources"] = map[string]any{
+		"limits": map[string]any{"nvidia.com/gpu": "1"},
+	}
+	_ = unstructured.SetNestedSlice(sr, containers, "spec", "containers")
+
+	return sr
+}
+
+func TestAcceleratorDefaultsAction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	vllm := withGPU(newServingRuntime("vllm-runtime", map[string]any{
+		"tolerations":  []any{map[string]any{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}},
+		"nodeSelector": map[string]any{"node.kubernetes.io/instance-type": "g6.xlarge"},
+	}))
+	ovms := newServingRuntime("ovms", map[string]any{})
+
+	recommended := newServingRuntime("caikit-tgis-runtime", map[string]any{})
+	recommended["metadata"].(map[string]any)["annotations"] = map[string]any{
+		annotations.RecommendedAccelerators: `["nvidia.com/gpu"]`,
+	}
+
+	template := map[string]any{
+		"apiVersion": gvk.OpenshiftTemplate.GroupVersion().String(),
+		"kind":       gvk.OpenshiftTemplate.Kind,
+		"metadata":   map[string]any{"name": "caikit-tgis-serving-template"},
+		"objects":    []any{recommended},
+	}
+
+	instance := componentApi.ModelController{}
+	instance.Spec.AcceleratorDefaults = []common.AcceleratorDefaults{gpuDefaults}
+
+	rr := types.ReconciliationRequest{
+		Instance: &instance,
+		Resources: []unstructured.Unstructured{
+			{Object: vllm},
+			{Object: ovms},
+			{Object: template},
+		},
+	}
+
+	err := acceleratordefaults.NewAction()(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rr.Resources[0]).Should(And(
+		jq.Match(`.spec.tolerations | length == 1`),
+		jq.Match(`.spec.nodeSelector == {"node.kubernetes.io/instance-type": "g6.xlarge", "nvidia.com/gpu.present": "true"}`),
+	))
+	g.Expect(rr.Resources[1]).Should(And(
+		jq.Match(`.spec | has("tolerations") | not`),
+		jq.Match(`.spec | has("nodeSelector") | not`),
+	))
+	g.Expect(rr.Resources[2]).Should(And(
+		jq.Match(`.objects[0].spec.tolerations == [{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}]`),
+		jq.Match(`.objects[0].spec.nodeSelector."node.kubernetes.io/instance-type" == "g5.2xlarge"`),
+	))
+}
+
+// the runtimes are laid out as in the config/runtimes directory of odh-model-controller, which ships
+// them as Templates for the dashboard to instantiate.
+const testRuntimesKustomization = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+resources:
+- vllm-cuda-template.yaml
+- ovms-kserve-template.yaml
+`
+
+const testVllmCudaTemplate = `
+apiVersion: template.openshift.io/v1
+kind: Template
+metadata:
+  annotations:
+    openshift.io/display-name: vLLM NVIDIA GPU ServingRuntime for KServe
+    opendatahub.io/modelServingSupport: '["single"]'
+    opendatahub.io/apiProtocol: REST
+  labels:
+    opendatahub.io/dashboard: "true"
+  name: vllm-cuda-runtime-template
+objects:
+- apiVersion: serving.kserve.io/v1alpha1
+  kind: ServingRuntime
+  metadata:
+    name: vllm-cuda-runtime
+    annotations:
+      openshift.io/display-name: vLLM NVIDIA GPU ServingRuntime for KServe
+      opendatahub.io/recommended-accelerators: '["nvidia.com/gpu"]'
+    labels:
+      opendatahub.io/dashboard: "true"
+  spec:
+    annotations:
+      prometheus.io/port: "8080"
+      prometheus.io/path: /metrics
+    multiModel: false
+    supportedModelFormats:
+    - autoSelect: true
+      name: vLLM
+    containers:
+    - name: kserve-container
+      image: $(vllm-cuda-image)
+      command: ["python", "-m", "vllm.entrypoints.openai.api_server"]
+      args: ["--port=8080", "--model=/mnt/models", "--served-model-name={{.Name}}"]
+      ports:
+      - containerPort: 8080
+        protocol: TCP
+`
+
+const testOvmsTemplate = `
+apiVersion: template.openshift.io/v1
+kind: Template
+metadata:
+  annotations:
+    openshift.io/display-name: OpenVINO Model Server
+    opendatahub.io/modelServingSupport: '["single"]'
+  labels:
+    opendatahub.io/dashboard: "true"
+  name: kserve-ovms
+objects:
+- apiVersion: serving.kserve.io/v1alpha1
+  kind: ServingRuntime
+  metadata:
+    name: kserve-ovms
+    annotations:
+      openshift.io/display-name: OpenVINO Model Server
+  spec:
+    multiModel: false
+    supportedModelFormats:
+    - autoSelect: true
+      name: openvino_ir
+      version: opset13
+    containers:
+    - name: kserve-container
+      image: $(ovms-image)
+      args: ["--model_name={{.Name}}", "--port=8001", "--rest_port=8888", "--model_path=/mnt/models"]
+      ports:
+      - containerPort: 8888
+        protocol: TCP
+`
+
+func TestAcceleratorDefaultsActionRenderedRuntimes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ns := xid.New().String()
+	id := xid.New().String()
+	fs := filesys.MakeFsInMemory()
+
+	_ = fs.MkdirAll(path.Join(id, mk.DefaultKustomizationFilePath))
+	_ = fs.WriteFile(path.Join(id, mk.DefaultKustomizationFileName), []byte(testRuntimesKustomization))
+	_ = fs.WriteFile(path.Join(id, "vllm-cuda-template.yaml"), []byte(testVllmCudaTemplate))
+	_ = fs.WriteFile(path.Join(id, "ovms-kserve-template.yaml"), []byte(testOvmsTemplate))
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	instance := componentApi.ModelController{}
+	instance.Spec.AcceleratorDefaults = []common.AcceleratorDefaults{gpuDefaults}
+
+	rr := types.ReconciliationRequest{
+		Client:    cl,
+		Instance:  &instance,
+		DSCI:      &dsciv1.DSCInitialization{Spec: dsciv1.DSCInitializationSpec{ApplicationsNamespace: ns}},
+		Release:   cluster.Release{Name: cluster.OpenDataHub},
+		Manifests: []types.ManifestInfo{{Path: id}},
+	}
+
+	render := kustomize.NewAction(kustomize.WithManifestsOptions(mk.WithEngineFS(fs)))
+	g.Expect(render(ctx, &rr)).Should(Succeed())
+	g.Expect(acceleratordefaults.NewAction()(ctx, &rr)).Should(Succeed())
+
+	g.Expect(rr.Resources).Should(HaveExactElements(
+		And(
+			jq.Match(`.metadata.name == "vllm-cuda-runtime-template"`),
+			jq.Match(`.objects[0].spec.tolerations == [{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}]`),
+			jq.Match(`.objects[0].spec.nodeSelector."nvidia.com/gpu.present" == "true"`),
+			jq.Match(`.objects[0].spec.containers[0].image == "$(vllm-cuda-image)"`),
+		),
+		And(
+			jq.Match(`.metadata.name == "kserve-ovms"`),
+			jq.Match(`.objects[0].spec | has("tolerations") | not`),
+			jq.Match(`.objects[0].spec | has("nodeSelector") | not`),
+		),
+	))
+}
+
+func newPyTorchJob(name string) *unstructured.Unstructured {
+	replica := func(gpus string) map[string]any {
+		container := map[string]any{"name": "pytorch", "image": "quay.io/modh/training:py311-cuda121-torch241"}
+		if gpus != "" {
+			container["resources"] = map[string]any{
+				"limits": map[string]any{"nvidia.com/gpu": gpus},
+			}
+		}
+
+		return map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{"containers": []any{container}},
+			},
+		}
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": gvk.PyTorchJob.GroupVersion().String(),
+		"kind":       gvk.PyTorchJob.Kind,
+		"metadata":   map[string]any{"name": name, "namespace": "user-project"},
+		"spec": map[string]any{
+			"pytorchReplicaSpecs": map[string]any{
+				"Master": replica("1"),
+				"Worker": replica(""),
+			},
+		},
+	}}
+}
+
+func TestApplyTrainingJob(t *testing.T) {
+	g := NewWithT(t)
+
+	job := newPyTorchJob("fine-tuning")
+	g.Expect(acceleratordefaults.Apply(job, []common.AcceleratorDefaults{gpuDefaults})).Should(Succeed())
+
+	g.Expect(job).Should(And(
+		jq.Match(`.spec.pytorchReplicaSpecs.Master.template.spec.tolerations == [{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}]`),
+		jq.Match(`.spec.pytorchReplicaSpecs.Master.template.spec.nodeSelector."nvidia.com/gpu.present" == "true"`),
+		jq.Match(`.spec.pytorchReplicaSpecs.Worker.template.spec | has("tolerations") | not`),
+		jq.Match(`.spec.pytorchReplicaSpecs.Worker.template.spec | has("nodeSelector") | not`),
+	))
+
+	recommended := newPyTorchJob("fine-tuning-recommended")
+	recommended.SetAnnotations(map[string]string{
+		annotations.RecommendedAccelerators: `["nvidia.com/gpu"]`,
+	})
+	g.Expect(acceleratordefaults.Apply(recommended, []common.AcceleratorDefaults{gpuDefaults})).Should(Succeed())
+
+	g.Expect(recommended).Should(And(
+		jq.Match(`.spec.pytorchReplicaSpecs.Master.template.spec.tolerations | length == 1`),
+		jq.Match(`.spec.pytorchReplicaSpecs.Worker.template.spec.tolerations | length == 1`),
+		jq.Match(`.spec.pytorchReplicaSpecs.Worker.template.spec.nodeSelector."node.kubernetes.io/instance-type" == "g5.2xlarge"`),
+	))
+}
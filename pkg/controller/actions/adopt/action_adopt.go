@@ -0,0 +1,194 @@
+package adopt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// maxReportedResources limits the number of conflicting resources listed in the condition message.
+	maxReportedResources = 5
+)
+
+// Action detects resources about to be deployed which already exist in the cluster but have not
+// been deployed by the operator, i.e. because the component was installed by other means. Such
+// conflicts are reported on the Ready condition of the component and stop the reconciliation, unless
+// the component is annotated with annotations.AdoptionMode set to annotations.AdoptionModeAdopt, in
+// which case the operator labels the resources as its own and the deploy action takes over their
+// fields ownership.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	obj, ok := rr.Instance.(types.ResourceObject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a ResourceObject", rr.Instance)
+	}
+
+	conflicts, err := a.detect(ctx, rr)
+	if err != nil {
+		return err
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	if resources.GetAnnotation(obj, annotations.AdoptionMode) == annotations.AdoptionModeAdopt {
+		return a.adopt(ctx, rr, conflicts)
+	}
+
+	return odherrors.NewNotReadyStopError(rr, status.ExistingInstallationDetectedReason, errors.New(conflictsMessage(conflicts)))
+}
+
+func (a *Action) detect(ctx context.Context, rr *types.ReconciliationRequest) ([]unstructured.Unstructured, error) {
+	conflicts := make([]unstructured.Unstructured, 0)
+
+	for i := range rr.Resources {
+		res := rr.Resources[i]
+
+		// resources explicitly marked as not managed are only created if missing
+		if resources.GetAnnotation(&res, annotations.ManagedByODHOperator) == "false" {
+			continue
+		}
+
+		current := resources.GvkToUnstructured(res.GroupVersionKind())
+
+		err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&res), current)
+		switch {
+		case k8serr.IsNotFound(err):
+			continue
+		case meta.IsNoMatchError(err):
+			// the kind is not known yet (i.e. its CRD is part of the same render),
+			// so there cannot be any existing object conflicting with the resource
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to lookup object %s/%s: %w", res.GetNamespace(), res.GetName(), err)
+		}
+
+		if isDeployedByPlatform(current) {
+			continue
+		}
+
+		// the user has explicitly marked the existing object as not owned by the operator,
+		// so it is not going to be modified by the deploy action
+		if resources.GetAnnotation(current, annotations.ManagedByODHOperator) == "false" {
+			continue
+		}
+
+		conflicts = append(conflicts, *current)
+	}
+
+	return conflicts, nil
+}
+
+func (a *Action) adopt(ctx context.Context, rr *types.ReconciliationRequest, conflicts []unstructured.Unstructured) error {
+	l := logf.FromContext(ctx)
+
+	kind, err := resources.KindForObject(rr.Client.Scheme(), rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	for i := range conflicts {
+		obj := &conflicts[i]
+		orig := obj.DeepCopy()
+
+		resources.SetLabel(obj, labels.PlatformPartOf, strings.ToLower(kind))
+
+		if err := rr.Client.Patch(ctx, obj, client.MergeFrom(orig)); err != nil {
+			return fmt.Errorf("failed to adopt object %s %s/%s: %w", obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		l.Info("adopted existing resource", "gvk", obj.GroupVersionKind(), "name", client.ObjectKeyFromObject(obj))
+	}
+
+	return nil
+}
+
+// isDeployedByPlatform returns true if the object carries any of the markers the operator sets when deploying
+// a resource, including the ones set by the previous versions of the operator.
+func isDeployedByPlatform(obj *unstructured.Unstructured) bool {
+	if resources.GetLabel(obj, labels.PlatformPartOf) != "" {
+		return true
+	}
+
+	if resources.GetAnnotation(obj, annotations.InstanceUID) != "" {
+		return true
+	}
+
+	for k := range obj.GetLabels() {
+		if strings.HasPrefix(k, labels.ODHAppPrefix) {
+			return true
+		}
+	}
+
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+
+		switch gv.Group {
+		case componentApi.GroupVersion.Group, featurev1.GroupVersion.Group, gvk.DataScienceCluster.Group, gvk.DSCInitialization.Group:
+			return true
+		}
+	}
+
+	return false
+}
+
+func conflictsMessage(conflicts []unstructured.Unstructured) string {
+	names := make([]string, 0, maxReportedResources)
+	for i := range conflicts {
+		if i == maxReportedResources {
+			names = append(names, fmt.Sprintf("and %d more", len(conflicts)-maxReportedResources))
+			break
+		}
+
+		name := conflicts[i].GetName()
+		if ns := conflicts[i].GetNamespace(); ns != "" {
+			name = ns + "/" + name
+		}
+
+		names = append(names, conflicts[i].GetKind()+" "+name)
+	}
+
+	return fmt.Sprintf(
+		"Found resources not deployed by this operator, the component might have been installed by other means: %s. "+
+			"Remove them or set the %s annotation to %s on the component to let the operator take ownership",
+		strings.Join(names, ", "), annotations.AdoptionMode, annotations.AdoptionModeAdopt)
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,163 @@
+package adopt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/xid"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/adopt"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDeployment(ns string, name string, l map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Deployment.GroupVersion().String(),
+			Kind:       gvk.Deployment.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels:    l,
+		},
+	}
+}
+
+func newRequest(t *testing.T, rr *types.ReconciliationRequest, ns string, objs ...client.Object) {
+	t.Helper()
+
+	for _, obj := range objs {
+		u, err := resources.ToUnstructured(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	rr.DSCI = &dsciv1.DSCInitialization{Spec: dsciv1.DSCInitializationSpec{ApplicationsNamespace: ns}}
+	rr.Release = cluster.Release{Name: cluster.OpenDataHub}
+}
+
+func TestAdoptActionDetectsConflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	cl, err := fakeclient.New(
+		newDeployment(ns, "foreign", nil),
+		newDeployment(ns, "managed", map[string]string{labels.PlatformPartOf: "dashboard"}),
+		newDeployment(ns, "legacy", map[string]string{labels.ODH.Component("dashboard"): labels.True}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{},
+	}
+
+	newRequest(t, &rr, ns,
+		newDeployment(ns, "foreign", nil),
+		newDeployment(ns, "managed", nil),
+		newDeployment(ns, "legacy", nil),
+		newDeployment(ns, "new", nil),
+	)
+
+	err = adopt.NewAction()(ctx, &rr)
+	g.Expect(errors.As(err, &odherrors.StopError{})).Should(BeTrue())
+
+	g.Expect(rr.Instance).Should(
+		WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.phase == "NotReady"`),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionTypeReady, metav1.ConditionFalse),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionTypeReady, status.ExistingInstallationDetectedReason),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("Deployment %s/foreign")`, status.ConditionTypeReady, ns),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("/managed") | not`, status.ConditionTypeReady),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("/legacy") | not`, status.ConditionTypeReady),
+		)),
+	)
+}
+
+func TestAdoptActionAdoptsConflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	cl, err := fakeclient.New(
+		newDeployment(ns, "foreign", nil),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		Instance: &componentApi.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					annotations.AdoptionMode: annotations.AdoptionModeAdopt,
+				},
+			},
+		},
+	}
+
+	newRequest(t, &rr, ns, newDeployment(ns, "foreign", nil))
+
+	err = adopt.NewAction()(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	adopted := unstructured.Unstructured{}
+	adopted.SetGroupVersionKind(gvk.Deployment)
+
+	err = cl.Get(ctx, client.ObjectKey{Namespace: ns, Name: "foreign"}, &adopted)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resources.GetLabel(&adopted, labels.PlatformPartOf)).Should(Equal("dashboard"))
+}
+
+func TestAdoptActionIgnoresKindsNotInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	crd := unstructured.Unstructured{}
+	crd.SetGroupVersionKind(gvk.CustomResourceDefinition)
+	crd.SetName("odhapplications.dashboard.opendatahub.io")
+
+	app := unstructured.Unstructured{}
+	app.SetGroupVersionKind(gvk.OdhApplication)
+	app.SetNamespace(ns)
+	app.SetName("jupyter")
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{},
+	}
+
+	newRequest(t, &rr, ns, &crd, &app)
+
+	err = adopt.NewAction()(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 func MergeDeployments(source *unstructured.Unstructured, target *unstructured.Unstructured) error {
@@ -91,6 +93,13 @@ func MergeDeployments(source *unstructured.Unstructured, target *unstructured.Un
 	// Replicas
 	//
 
+	// the replicas of the manifest are kept while they are managed by the platform, and when they
+	// stop being so (i.e. the annotation is still on the live object only) so that the Deployment goes
+	// back to the replicas of the manifest instead of keeping the managed ones
+	if target.GetAnnotations()[annotations.ManagedReplicas] == "true" || source.GetAnnotations()[annotations.ManagedReplicas] == "true" {
+		return nil
+	}
+
 	sourceReplica, ok, err := unstructured.NestedFieldNoCopy(source.Object, replicasPath...)
 	if err != nil {
 		return err
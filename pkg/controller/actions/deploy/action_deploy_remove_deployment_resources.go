@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 func RemoveDeploymentsResources(obj *unstructured.Unstructured) error {
@@ -40,7 +42,9 @@ func RemoveDeploymentsResources(obj *unstructured.Unstructured) error {
 	// Replicas
 	//
 
-	unstructured.RemoveNestedField(obj.Object, replicasPath...)
+	if obj.GetAnnotations()[annotations.ManagedReplicas] != "true" {
+		unstructured.RemoveNestedField(obj.Object, replicasPath...)
+	}
 
 	return nil
 }
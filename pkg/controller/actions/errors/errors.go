@@ -2,6 +2,12 @@ package errors
 
 import (
 	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
 // StopError is a marker error that thew ComponentController uses
@@ -22,3 +28,25 @@ func NewStopError(format string, args ...any) StopError {
 		fmt.Errorf(format, args...),
 	}
 }
+
+// NewNotReadyStopError sets the Ready condition of the instance being reconciled to False, with the
+// given reason and the error as message, and wraps the error in a StopError.
+func NewNotReadyStopError(rr *types.ReconciliationRequest, reason string, err error) error {
+	obj, ok := rr.Instance.(types.ResourceObject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a ResourceObject", rr.Instance)
+	}
+
+	s := obj.GetStatus()
+	s.Phase = "NotReady"
+
+	meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+		Type:               status.ConditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            err.Error(),
+		ObservedGeneration: s.ObservedGeneration,
+	})
+
+	return NewStopErrorW(err)
+}
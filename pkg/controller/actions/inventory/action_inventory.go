@@ -0,0 +1,161 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action records in the status of the component the objects the operator manages for it, together
+// with their health as observed on the cluster. Only a summary, with the objects which are not Healthy,
+// is exposed in the status of the DataScienceCluster.
+//
+// The action must be executed after the resources are deployed.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	obj, ok := rr.Instance.(common.WithResourcesStatus)
+	if !ok {
+		return nil
+	}
+
+	items := make([]common.ResourceStatus, 0, len(rr.Resources))
+
+	for i := range rr.Resources {
+		res := &rr.Resources[i]
+		current := resources.GvkToUnstructured(res.GroupVersionKind())
+
+		err := rr.Client.Get(ctx, client.ObjectKeyFromObject(res), current)
+		switch {
+		case k8serr.IsNotFound(err):
+			current = nil
+		case err != nil:
+			return fmt.Errorf("failed to lookup object %s/%s: %w", res.GetNamespace(), res.GetName(), err)
+		case resources.GetAnnotation(current, annotations.ManagedByODHOperator) == "false":
+			// the object is explicitly not owned by the operator
+			continue
+		}
+
+		item := common.ResourceStatus{
+			Group:     res.GroupVersionKind().Group,
+			Version:   res.GroupVersionKind().Version,
+			Kind:      res.GetKind(),
+			Namespace: res.GetNamespace(),
+			Name:      res.GetName(),
+		}
+
+		item.Health, item.Message = Health(current)
+
+		items = append(items, item)
+	}
+
+	obj.GetResourcesStatus().Resources = items
+	obj.GetResourcesSummaryStatus().ResourcesSummary = summarize(items)
+
+	return nil
+}
+
+func summarize(items []common.ResourceStatus) *common.ResourcesSummary {
+	summary := common.ResourcesSummary{
+		Total: int32(len(items)),
+	}
+
+	for _, item := range items {
+		if item.Health != common.ResourceHealthy {
+			summary.UnhealthyResources = append(summary.UnhealthyResources, item)
+		}
+	}
+
+	summary.Unhealthy = int32(len(summary.UnhealthyResources))
+
+	return &summary
+}
+
+// Health computes the health of an object from its status, a nil object is reported as Unhealthy.
+func Health(obj *unstructured.Unstructured) (common.ResourceHealth, string) {
+	if obj == nil {
+		return common.ResourceUnhealthy, "not found"
+	}
+
+	switch obj.GroupVersionKind() {
+	case gvk.Deployment, gvk.StatefulSet:
+		return replicasHealth(obj)
+	case gvk.CustomResourceDefinition:
+		return conditionHealth(obj, "Established")
+	default:
+		return conditionHealth(obj, "Ready", "Available")
+	}
+}
+
+// replicasHealth compares the ready replicas to the desired ones, the counts are not reported in the
+// message as they change while the workload is scaled, which would update the status each time.
+func replicasHealth(obj *unstructured.Unstructured) (common.ResourceHealth, string) {
+	replicas, _, err := unstructured.NestedInt64(obj.Object, "status", "replicas")
+	if err != nil {
+		return common.ResourceUnknown, err.Error()
+	}
+
+	ready, _, err := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	if err != nil {
+		return common.ResourceUnknown, err.Error()
+	}
+
+	if ready != replicas {
+		return common.ResourceUnhealthy, "not all the replicas are ready"
+	}
+
+	return common.ResourceHealthy, ""
+}
+
+// conditionHealth looks for the first of the given condition types in the status of the object, objects
+// that don't report any of them don't have a notion of health and are considered Healthy.
+func conditionHealth(obj *unstructured.Unstructured, conditionTypes ...string) (common.ResourceHealth, string) {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return common.ResourceUnknown, err.Error()
+	}
+
+	for _, t := range conditionTypes {
+		for i := range conditions {
+			c, ok := conditions[i].(map[string]any)
+			if !ok || c["type"] != t {
+				continue
+			}
+
+			message, _ := c["message"].(string)
+
+			switch c["status"] {
+			case "True":
+				return common.ResourceHealthy, message
+			case "False":
+				return common.ResourceUnhealthy, message
+			default:
+				return common.ResourceUnknown, message
+			}
+		}
+	}
+
+	return common.ResourceHealthy, ""
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
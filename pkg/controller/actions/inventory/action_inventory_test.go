@@ -0,0 +1,130 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/xid"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/inventory"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestInventoryAction(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	ready := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gvk.Deployment.GroupVersion().String(), Kind: gvk.Deployment.Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: ns},
+		Status:     appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2},
+	}
+	notReady := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gvk.Deployment.GroupVersion().String(), Kind: gvk.Deployment.Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: "not-ready", Namespace: ns},
+		Status:     appsv1.DeploymentStatus{Replicas: 1, ReadyReplicas: 0},
+	}
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: ns},
+	}
+	unmanaged := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "unmanaged",
+			Namespace:   ns,
+			Annotations: map[string]string{annotations.ManagedByODHOperator: "false"},
+		},
+	}
+	missing := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: ns},
+	}
+
+	cl, err := fakeclient.New(ready, notReady, cm, unmanaged)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{},
+	}
+
+	for _, obj := range []any{ready, notReady, cm, unmanaged, missing} {
+		u, err := resources.ToUnstructured(obj)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	err = inventory.NewAction()(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	unhealthy := []common.ResourceStatus{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: ns, Name: "not-ready", Health: common.ResourceUnhealthy, Message: "not all the replicas are ready"},
+		{Version: "v1", Kind: "ConfigMap", Namespace: ns, Name: "missing", Health: common.ResourceUnhealthy, Message: "not found"},
+	}
+
+	status := rr.Instance.(*componentApi.Dashboard).Status
+
+	g.Expect(status.Resources).Should(Equal([]common.ResourceStatus{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: ns, Name: "ready", Health: common.ResourceHealthy},
+		unhealthy[0],
+		{Version: "v1", Kind: "ConfigMap", Namespace: ns, Name: "config", Health: common.ResourceHealthy},
+		unhealthy[1],
+	}))
+
+	// only the summary is exposed in the DataScienceCluster
+	g.Expect(status.ResourcesSummary).Should(Equal(&common.ResourcesSummary{
+		Total:              4,
+		Unhealthy:          2,
+		UnhealthyResources: unhealthy,
+	}))
+}
+
+func TestHealthConditions(t *testing.T) {
+	newObject := func(status string) *unstructured.Unstructured {
+		u := unstructured.Unstructured{Object: map[string]any{
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": status, "message": "some message"},
+				},
+			},
+		}}
+		u.SetGroupVersionKind(gvk.DataScienceCluster)
+
+		return &u
+	}
+
+	tests := map[string]struct {
+		obj    *unstructured.Unstructured
+		health common.ResourceHealth
+	}{
+		"ready":     {obj: newObject("True"), health: common.ResourceHealthy},
+		"not ready": {obj: newObject("False"), health: common.ResourceUnhealthy},
+		"unknown":   {obj: newObject("Unknown"), health: common.ResourceUnknown},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			health, message := inventory.Health(tt.obj)
+			g.Expect(health).Should(Equal(tt.health))
+			g.Expect(message).Should(Equal("some message"))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action defers the changes to the resources of a component or service while the maintenance windows
+// configured for it in the DSCInitialization are closed. The target of the windows is the lowercase
+// kind of the instance, e.g. `dashboard` or `monitoring`.
+//
+// Outside of the windows, the resources which have not been deployed for the current generation of the
+// instance and release of the platform are removed from the ReconciliationRequest, so that upgrades and
+// configuration changes are not rolled out. The resources which are missing or up-to-date are kept, so
+// that deleted resources are re-created and manual changes are reverted. The garbage collection is
+// skipped as well, as it would remove the resources whose changes have been deferred.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	deferred map[k8stypes.UID]struct{}
+}
+
+type ActionOpts func(*Action)
+
+// WithClock sets the function returning the current time, time.Now by default.
+func WithClock(fn func() time.Time) ActionOpts {
+	return func(action *Action) {
+		action.now = fn
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	obj, ok := rr.Instance.(types.ResourceObject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a ResourceObject", rr.Instance)
+	}
+
+	if rr.DSCI == nil {
+		return nil
+	}
+
+	kind, err := resources.KindForObject(rr.Client.Scheme(), rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	now := a.now()
+	s := obj.GetStatus()
+
+	state, err := maintenance.Evaluate(rr.DSCI.Spec.Maintenance, strings.ToLower(kind), now)
+	switch {
+	case err != nil:
+		// changes are only rolled out once the windows are fixed
+		if _, err := a.deferChanges(ctx, rr); err != nil {
+			return err
+		}
+
+		rr.RequeueAfter = maintenance.RecheckPeriod
+
+		meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+			Type:               status.ConditionTypeMaintenanceWindow,
+			Status:             metav1.ConditionFalse,
+			Reason:             status.InvalidMaintenanceWindowReason,
+			Message:            err.Error(),
+			ObservedGeneration: s.ObservedGeneration,
+		})
+
+	case !state.Restricted:
+		a.resume(rr)
+
+		meta.RemoveStatusCondition(&s.Conditions, status.ConditionTypeMaintenanceWindow)
+
+	case state.Open:
+		a.resume(rr)
+
+		meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+			Type:               status.ConditionTypeMaintenanceWindow,
+			Status:             metav1.ConditionTrue,
+			Reason:             status.MaintenanceWindowOpenReason,
+			Message:            "Changes are rolled out until " + state.Until.Format(time.RFC3339),
+			ObservedGeneration: s.ObservedGeneration,
+		})
+
+	default:
+		deferred, err := a.deferChanges(ctx, rr)
+		if err != nil {
+			return err
+		}
+
+		rr.RequeueAfter = min(state.Next.Sub(now), maintenance.RecheckPeriod)
+
+		meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+			Type:   status.ConditionTypeMaintenanceWindow,
+			Status: metav1.ConditionFalse,
+			Reason: status.ChangesDeferredReason,
+			Message: fmt.Sprintf("%d changes deferred until the next maintenance window at %s",
+				deferred, state.Next.Format(time.RFC3339)),
+			ObservedGeneration: s.ObservedGeneration,
+		})
+	}
+
+	return nil
+}
+
+// deferChanges removes from the request the resources that would be changed and returns their number.
+func (a *Action) deferChanges(ctx context.Context, rr *types.ReconciliationRequest) (int, error) {
+	kept := make([]unstructured.Unstructured, 0, len(rr.Resources))
+
+	for i := range rr.Resources {
+		res := rr.Resources[i]
+
+		current := resources.GvkToUnstructured(res.GroupVersionKind())
+		err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&res), current)
+		switch {
+		case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+			// the kind is not known yet when its CRD is part of the same render, the resource
+			// is created together with it
+			kept = append(kept, res)
+			continue
+		case err != nil:
+			return 0, fmt.Errorf("failed to lookup object %s/%s: %w", res.GetNamespace(), res.GetName(), err)
+		}
+
+		if upToDate(rr, current) {
+			kept = append(kept, res)
+			continue
+		}
+
+		logf.FromContext(ctx).V(3).Info("deferring changes",
+			"gvk", res.GroupVersionKind(),
+			"name", client.ObjectKeyFromObject(&res),
+		)
+	}
+
+	deferred := len(rr.Resources) - len(kept)
+	if deferred == 0 {
+		return 0, nil
+	}
+
+	rr.Resources = kept
+	rr.Generated = false
+
+	a.mu.Lock()
+	a.deferred[rr.Instance.GetUID()] = struct{}{}
+	a.mu.Unlock()
+
+	return deferred, nil
+}
+
+// resume makes sure the garbage collection skipped while the changes were deferred is performed.
+func (a *Action) resume(rr *types.ReconciliationRequest) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.deferred[rr.Instance.GetUID()]; !ok {
+		return
+	}
+
+	delete(a.deferred, rr.Instance.GetUID())
+	rr.Generated = true
+}
+
+// upToDate returns true if the object has been deployed for the current generation of the instance
+// and release of the platform.
+func upToDate(rr *types.ReconciliationRequest, obj *unstructured.Unstructured) bool {
+	return resources.GetAnnotation(obj, annotations.InstanceGeneration) == strconv.FormatInt(rr.Instance.GetGeneration(), 10) &&
+		resources.GetAnnotation(obj, annotations.PlatformVersion) == rr.Release.Version.String()
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		now:      time.Now,
+		deferred: map[k8stypes.UID]struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
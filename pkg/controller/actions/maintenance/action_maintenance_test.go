@@ -0,0 +1,229 @@
+package maintenance_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/api/pkg/lib/version"
+	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const generation = 2
+
+var release = cluster.Release{
+	Name:    cluster.OpenDataHub,
+	Version: version.OperatorVersion{Version: semver.Version{Major: 1, Minor: 2, Patch: 3}},
+}
+
+func newConfigMap(ns string, name string, generation int) *corev1.ConfigMap {
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Annotations: map[string]string{
+				annotations.InstanceGeneration: strconv.Itoa(generation),
+				annotations.PlatformVersion:    release.Version.String(),
+			},
+		},
+	}
+
+	return &cm
+}
+
+// newRequest returns a request rendering the up-to-date, outdated and missing config maps, the
+// first two existing on the cluster.
+func newRequest(t *testing.T, ns string, spec *dsciv1.MaintenanceSpec) *types.ReconciliationRequest {
+	t.Helper()
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New(
+		newConfigMap(ns, "up-to-date", generation),
+		newConfigMap(ns, "outdated", generation-1),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client:    cl,
+		DSCI:      &dsciv1.DSCInitialization{Spec: dsciv1.DSCInitializationSpec{Maintenance: spec}},
+		Instance:  &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Generation: generation, UID: "uid"}},
+		Release:   release,
+		Generated: true,
+	}
+
+	for _, name := range []string{"up-to-date", "outdated", "missing"} {
+		u, err := resources.ToUnstructured(newConfigMap(ns, name, generation))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	return &rr
+}
+
+func names(rr *types.ReconciliationRequest) []string {
+	result := make([]string, 0, len(rr.Resources))
+	for i := range rr.Resources {
+		result = append(result, rr.Resources[i].GetName())
+	}
+
+	return result
+}
+
+func TestMaintenanceAction(t *testing.T) {
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	// a Saturday
+	now := time.Date(2024, time.May, 18, 23, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	window := func(schedule string, targets ...string) *dsciv1.MaintenanceSpec {
+		return &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{{
+			Schedule: schedule,
+			Duration: metav1.Duration{Duration: 2 * time.Hour},
+			Targets:  targets,
+		}}}
+	}
+
+	tests := map[string]struct {
+		spec      *dsciv1.MaintenanceSpec
+		resources []string
+		generated bool
+		requeue   time.Duration
+		reason    string
+	}{
+		"no maintenance windows": {
+			resources: []string{"up-to-date", "outdated", "missing"},
+			generated: true,
+		},
+		"window of another component": {
+			spec:      window("0 2 * * SUN", "kserve"),
+			resources: []string{"up-to-date", "outdated", "missing"},
+			generated: true,
+		},
+		"open window": {
+			spec:      window("0 22 * * SAT", "dashboard"),
+			resources: []string{"up-to-date", "outdated", "missing"},
+			generated: true,
+			reason:    status.MaintenanceWindowOpenReason,
+		},
+		"closed window": {
+			spec:      window("10 23 * * *"),
+			resources: []string{"up-to-date", "missing"},
+			requeue:   10 * time.Minute,
+			reason:    status.ChangesDeferredReason,
+		},
+		"invalid window": {
+			spec:      window("10 23 * *"),
+			resources: []string{"up-to-date", "missing"},
+			requeue:   15 * time.Minute,
+			reason:    status.InvalidMaintenanceWindowReason,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			rr := newRequest(t, ns, tt.spec)
+
+			err := maintenance.NewAction(maintenance.WithClock(clock))(ctx, rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(names(rr)).Should(Equal(tt.resources))
+			g.Expect(rr.Generated).Should(Equal(tt.generated))
+			g.Expect(rr.RequeueAfter).Should(Equal(tt.requeue))
+
+			u, err := resources.ToUnstructured(rr.Instance)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			if tt.reason == "" {
+				g.Expect(u).Should(jq.Match(`.status.conditions == null`))
+				return
+			}
+
+			g.Expect(u).Should(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeMaintenanceWindow, tt.reason),
+			)
+		})
+	}
+}
+
+func TestMaintenanceActionResume(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	now := time.Date(2024, time.May, 18, 23, 0, 0, 0, time.UTC)
+	spec := &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{{
+		Schedule: "10 23 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}}}
+
+	action := maintenance.NewAction(maintenance.WithClock(func() time.Time { return now }))
+
+	rr := newRequest(t, ns, spec)
+	err := action(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Generated).Should(BeFalse())
+
+	// once the window opens, the resources are not re-rendered as the instance has not changed, but
+	// the garbage collection skipped while the changes were deferred must be performed
+	now = now.Add(15 * time.Minute)
+
+	rr = newRequest(t, ns, spec)
+	rr.Generated = false
+	rr.Resources = []unstructured.Unstructured{}
+
+	err = action(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Generated).Should(BeTrue())
+}
+
+func TestMaintenanceActionKindsNotInstalled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	now := time.Date(2024, time.May, 18, 23, 0, 0, 0, time.UTC)
+	spec := &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{{
+		Schedule: "10 23 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}}}
+
+	// the CRD of the application is rendered together with it, so its kind is not known yet
+	app := unstructured.Unstructured{}
+	app.SetGroupVersionKind(gvk.OdhApplication)
+	app.SetNamespace(ns)
+	app.SetName("jupyter")
+
+	rr := newRequest(t, ns, spec)
+	rr.Resources = append(rr.Resources, app)
+
+	err := maintenance.NewAction(maintenance.WithClock(func() time.Time { return now }))(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(names(rr)).Should(Equal([]string{"up-to-date", "missing", "jupyter"}))
+}
@@ -0,0 +1,274 @@
+package podoverrides
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// MountPathPrefix is the only location where volumes of the pod overrides can be mounted, so
+// that they can't shadow any file the component images rely on.
+const MountPathPrefix = "/mnt/"
+
+var ErrInvalidPodOverrides = errors.New("invalid pod overrides")
+
+// Action applies the pod overrides of a component, if any, to the containers of all the rendered
+// Deployments. Invalid overrides, or overrides conflicting with the rendered resources, are reported
+// on the Ready condition of the component and stop the reconciliation.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(_ context.Context, rr *types.ReconciliationRequest) error {
+	inst, ok := rr.Instance.(common.WithPodOverrides)
+	if !ok || inst.GetPodOverrides() == nil {
+		return nil
+	}
+
+	po := inst.GetPodOverrides()
+
+	err := Validate(po)
+	if err == nil {
+		for i := range rr.Resources {
+			if rr.Resources[i].GroupVersionKind() != gvk.Deployment {
+				continue
+			}
+
+			if err = apply(&rr.Resources[i], po); err != nil {
+				break
+			}
+		}
+	}
+
+	switch {
+	case errors.Is(err, ErrInvalidPodOverrides):
+		return odherrors.NewNotReadyStopError(rr, status.InvalidPodOverridesReason, err)
+	case err != nil:
+		return fmt.Errorf("failed to apply pod overrides: %w", err)
+	default:
+		return nil
+	}
+}
+
+// Validate checks that the pod overrides are consistent on their own, conflicts with the
+// resources of the component are only detected when the overrides are applied.
+func Validate(po *common.PodOverrides) error {
+	envs := make(map[string]struct{}, len(po.Env))
+	for _, e := range po.Env {
+		if _, found := envs[e.Name]; found {
+			return fmt.Errorf("%w: env %s is defined more than once", ErrInvalidPodOverrides, e.Name)
+		}
+		envs[e.Name] = struct{}{}
+	}
+
+	volumes := make(map[string]struct{}, len(po.Volumes))
+	for _, v := range po.Volumes {
+		if _, found := volumes[v.Name]; found {
+			return fmt.Errorf("%w: volume %s is defined more than once", ErrInvalidPodOverrides, v.Name)
+		}
+		if countSources(v) != 1 {
+			return fmt.Errorf("%w: exactly one of configMap, secret or emptyDir must be set for volume %s", ErrInvalidPodOverrides, v.Name)
+		}
+		volumes[v.Name] = struct{}{}
+	}
+
+	mountPaths := make(map[string]struct{}, len(po.VolumeMounts))
+	for _, vm := range po.VolumeMounts {
+		if _, found := volumes[vm.Name]; !found {
+			return fmt.Errorf("%w: volume mount %s references undefined volume %s", ErrInvalidPodOverrides, vm.MountPath, vm.Name)
+		}
+		if !strings.HasPrefix(vm.MountPath, MountPathPrefix) || path.Clean(vm.MountPath) != vm.MountPath {
+			return fmt.Errorf("%w: mount path %s must be a clean path under %s", ErrInvalidPodOverrides, vm.MountPath, MountPathPrefix)
+		}
+		if path.IsAbs(vm.SubPath) || strings.Contains(vm.SubPath, "..") {
+			return fmt.Errorf("%w: sub path %s of volume mount %s must be relative to the volume", ErrInvalidPodOverrides, vm.SubPath, vm.MountPath)
+		}
+		if _, found := mountPaths[vm.MountPath]; found {
+			return fmt.Errorf("%w: mount path %s is used more than once", ErrInvalidPodOverrides, vm.MountPath)
+		}
+		mountPaths[vm.MountPath] = struct{}{}
+	}
+
+	return nil
+}
+
+func countSources(v common.PodOverridesVolume) int {
+	count := 0
+	for _, set := range []bool{v.ConfigMap != nil, v.Secret != nil, v.EmptyDir != nil} {
+		if set {
+			count++
+		}
+	}
+
+	return count
+}
+
+func apply(obj *unstructured.Unstructured, po *common.PodOverrides) error {
+	podSpecPath := []string{"spec", "template", "spec"}
+
+	volumes, _, err := unstructured.NestedSlice(obj.Object, append(podSpecPath, "volumes")...)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range po.Volumes {
+		if indexOf(volumes, "name", v.Name) != -1 {
+			return fmt.Errorf("%w: volume %s conflicts with a volume of deployment %s", ErrInvalidPodOverrides, v.Name, obj.GetName())
+		}
+
+		u, err := toUnstructured(&corev1.Volume{
+			Name: v.Name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: v.ConfigMap,
+				Secret:    v.Secret,
+				EmptyDir:  v.EmptyDir,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		volumes = append(volumes, u)
+	}
+
+	containers, _, err := unstructured.NestedSlice(obj.Object, append(podSpecPath, "containers")...)
+	if err != nil {
+		return err
+	}
+
+	for i := range containers {
+		container, ok := containers[i].(map[string]any)
+		if !ok {
+			return errors.New("field is not a map")
+		}
+
+		if err := applyToContainer(obj, container, po); err != nil {
+			return err
+		}
+	}
+
+	if len(volumes) != 0 {
+		if err := unstructured.SetNestedSlice(obj.Object, volumes, append(podSpecPath, "volumes")...); err != nil {
+			return err
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, append(podSpecPath, "containers")...)
+}
+
+func applyToContainer(obj *unstructured.Unstructured, container map[string]any, po *common.PodOverrides) error {
+	env, _, err := unstructured.NestedSlice(container, "env")
+	if err != nil {
+		return err
+	}
+
+	for i := range po.Env {
+		u, err := toUnstructured(&po.Env[i])
+		if err != nil {
+			return err
+		}
+
+		if idx := indexOf(env, "name", po.Env[i].Name); idx != -1 {
+			env[idx] = u
+		} else {
+			env = append(env, u)
+		}
+	}
+
+	envFrom, _, err := unstructured.NestedSlice(container, "envFrom")
+	if err != nil {
+		return err
+	}
+
+	for i := range po.EnvFrom {
+		u, err := toUnstructured(&po.EnvFrom[i])
+		if err != nil {
+			return err
+		}
+
+		envFrom = append(envFrom, u)
+	}
+
+	mounts, _, err := unstructured.NestedSlice(container, "volumeMounts")
+	if err != nil {
+		return err
+	}
+
+	for _, vm := range po.VolumeMounts {
+		if indexOf(mounts, "mountPath", vm.MountPath) != -1 {
+			return fmt.Errorf("%w: mount path %s conflicts with a volume mount of container %v in deployment %s",
+				ErrInvalidPodOverrides, vm.MountPath, container["name"], obj.GetName())
+		}
+
+		u, err := toUnstructured(&corev1.VolumeMount{
+			Name:      vm.Name,
+			MountPath: vm.MountPath,
+			SubPath:   vm.SubPath,
+			ReadOnly:  vm.ReadOnly,
+		})
+		if err != nil {
+			return err
+		}
+
+		mounts = append(mounts, u)
+	}
+
+	for k, v := range map[string][]any{"env": env, "envFrom": envFrom, "volumeMounts": mounts} {
+		if len(v) == 0 {
+			continue
+		}
+
+		container[k] = v
+	}
+
+	return nil
+}
+
+func indexOf(items []any, key string, value string) int {
+	for i := range items {
+		m, ok := items[i].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := m[key].(string); ok && v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func toUnstructured(obj any) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to unstructured: %w", obj, err)
+	}
+
+	return u, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,140 @@
+package podoverrides_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podoverrides"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRequest(t *testing.T, po *common.PodOverrides) *types.ReconciliationRequest {
+	t.Helper()
+
+	d := appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Deployment.GroupVersion().String(),
+			Kind:       gvk.Deployment.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "odh-dashboard",
+			Namespace: "opendatahub",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:         "dashboard",
+						Env:          []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}, {Name: "PORT", Value: "8080"}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/etc/config"}},
+					}},
+					Volumes: []corev1.Volume{{Name: "config"}},
+				},
+			},
+		},
+	}
+
+	u, err := resources.ToUnstructured(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instance := componentApi.Dashboard{}
+	instance.Spec.PodOverrides = po
+
+	return &types.ReconciliationRequest{
+		Instance:  &instance,
+		Resources: []unstructured.Unstructured{*u},
+	}
+}
+
+func TestPodOverridesAction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	rr := newRequest(t, &common.PodOverrides{
+		Env: []corev1.EnvVar{
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "HTTPS_PROXY", Value: "http://proxy:3128"},
+		},
+		EnvFrom: []corev1.EnvFromSource{{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "extra"}},
+		}},
+		Volumes: []common.PodOverridesVolume{{
+			Name:      "ca-bundle",
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "custom-ca"}},
+		}},
+		VolumeMounts: []common.PodOverridesVolumeMount{{
+			Name:      "ca-bundle",
+			MountPath: "/mnt/ca-bundle",
+			ReadOnly:  true,
+		}},
+	})
+
+	err := podoverrides.NewAction()(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rr.Resources[0]).Should(And(
+		jq.Match(`.spec.template.spec.containers[0].env == [
+			{"name": "LOG_LEVEL", "value": "debug"},
+			{"name": "PORT", "value": "8080"},
+			{"name": "HTTPS_PROXY", "value": "http://proxy:3128"}
+		]`),
+		jq.Match(`.spec.template.spec.containers[0].envFrom[0].secretRef.name == "extra"`),
+		jq.Match(`.spec.template.spec.containers[0].volumeMounts | length == 2`),
+		jq.Match(`.spec.template.spec.containers[0].volumeMounts[1] == {"name": "ca-bundle", "mountPath": "/mnt/ca-bundle", "readOnly": true}`),
+		jq.Match(`.spec.template.spec.volumes[1].name == "ca-bundle"`),
+		jq.Match(`.spec.template.spec.volumes[1].configMap.name == "custom-ca"`),
+	))
+}
+
+func TestPodOverridesActionInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]*common.PodOverrides{
+		"mount outside of the allowed path": {
+			Volumes:      []common.PodOverridesVolume{{Name: "tmp", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			VolumeMounts: []common.PodOverridesVolumeMount{{Name: "tmp", MountPath: "/opt/app-root"}},
+		},
+		"mount of an undefined volume": {
+			VolumeMounts: []common.PodOverridesVolumeMount{{Name: "tmp", MountPath: "/mnt/tmp"}},
+		},
+		"volume conflicting with the component": {
+			Volumes: []common.PodOverridesVolume{{Name: "config", EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+	}
+
+	for name, po := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			rr := newRequest(t, po)
+
+			err := podoverrides.NewAction()(ctx, rr)
+			g.Expect(errors.As(err, &odherrors.StopError{})).Should(BeTrue())
+			g.Expect(err.Error()).Should(ContainSubstring(podoverrides.ErrInvalidPodOverrides.Error()))
+
+			u, err := resources.ToUnstructured(rr.Instance)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			g.Expect(u).Should(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeReady, status.InvalidPodOverridesReason),
+			)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package restart
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+var restartTokenPath = []string{"spec", "template", "metadata", "annotations", annotations.RestartToken}
+
+// Action performs a rolling restart of the Deployments of a component when it is annotated with
+// annotations.RestartRequested. The Deployments are restarted one at a time, in the order they are
+// rendered, by setting the value of the annotation on their pod template: the next Deployment is only
+// restarted once the previous one has completed its rollout and all of its replicas are available.
+// The progress is reported on the Restarted condition of the component.
+//
+// Deployments which have not been restarted yet keep the token they have been restarted for last, so
+// that they are not restarted out of order, nor when the annotation is removed.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	obj, ok := rr.Instance.(types.ResourceObject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a ResourceObject", rr.Instance)
+	}
+
+	token := resources.GetAnnotation(obj, annotations.RestartRequested)
+	open := token != ""
+	pending := ""
+	total := 0
+	restarted := 0
+
+	for i := range rr.Resources {
+		res := &rr.Resources[i]
+		if res.GroupVersionKind() != gvk.Deployment {
+			continue
+		}
+
+		total++
+
+		current := resources.GvkToUnstructured(gvk.Deployment)
+		err := rr.Client.Get(ctx, client.ObjectKeyFromObject(res), current)
+		switch {
+		case k8serr.IsNotFound(err):
+			current = nil
+		case err != nil:
+			return fmt.Errorf("failed to lookup deployment %s/%s: %w", res.GetNamespace(), res.GetName(), err)
+		}
+
+		desired := currentToken(current)
+
+		switch {
+		case !open:
+			// a previous Deployment is being restarted
+		case desired != token:
+			logf.FromContext(ctx).Info("restarting deployment", "name", res.GetName(), "token", token)
+
+			desired = token
+			open = false
+			pending = res.GetName()
+		case !rolledOut(current):
+			open = false
+			pending = res.GetName()
+		default:
+			restarted++
+		}
+
+		if desired == "" {
+			continue
+		}
+
+		if err := unstructured.SetNestedField(res.Object, desired, restartTokenPath...); err != nil {
+			return fmt.Errorf("failed to set restart token on deployment %s: %w", res.GetName(), err)
+		}
+	}
+
+	if token == "" {
+		return nil
+	}
+
+	s := obj.GetStatus()
+
+	condition := metav1.Condition{
+		Type:               status.ConditionTypeRestarted,
+		Status:             metav1.ConditionTrue,
+		Reason:             status.RestartCompletedReason,
+		Message:            fmt.Sprintf("%d/%d deployments restarted for %s", restarted, total, token),
+		ObservedGeneration: s.ObservedGeneration,
+	}
+
+	if pending != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = status.RestartInProgressReason
+		condition.Message = fmt.Sprintf("%d/%d deployments restarted for %s, waiting for deployment %s",
+			restarted, total, token, pending)
+	}
+
+	meta.SetStatusCondition(&s.Conditions, condition)
+
+	return nil
+}
+
+func currentToken(current *unstructured.Unstructured) string {
+	if current == nil {
+		return ""
+	}
+
+	v, _, _ := unstructured.NestedString(current.Object, restartTokenPath...)
+
+	return v
+}
+
+// rolledOut returns true when the latest spec of the Deployment has been observed and all of its
+// replicas are updated and available, i.e. no pod of a previous revision is left.
+func rolledOut(current *unstructured.Unstructured) bool {
+	if current == nil {
+		return false
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(current.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+
+	observedGeneration, _, _ := unstructured.NestedInt64(current.Object, "status", "observedGeneration")
+	statusReplicas, _, _ := unstructured.NestedInt64(current.Object, "status", "replicas")
+	updatedReplicas, _, _ := unstructured.NestedInt64(current.Object, "status", "updatedReplicas")
+	availableReplicas, _, _ := unstructured.NestedInt64(current.Object, "status", "availableReplicas")
+
+	return observedGeneration >= current.GetGeneration() &&
+		statusReplicas == replicas &&
+		updatedReplicas == replicas &&
+		availableReplicas == replicas
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
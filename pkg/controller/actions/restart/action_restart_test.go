@@ -0,0 +1,187 @@
+package restart_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/xid"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/restart"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDeployment(ns string, name string, token string, rolledOut bool) *appsv1.Deployment {
+	d := appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Deployment.GroupVersion().String(),
+			Kind:       gvk.Deployment.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  ns,
+			Generation: 2,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			AvailableReplicas:  1,
+		},
+	}
+
+	if token != "" {
+		d.Spec.Template.Annotations = map[string]string{annotations.RestartToken: token}
+	}
+
+	if !rolledOut {
+		d.Status.Replicas = 2
+		d.Status.AvailableReplicas = 1
+	}
+
+	return &d
+}
+
+func newRequest(t *testing.T, token string, deployments ...*appsv1.Deployment) *types.ReconciliationRequest {
+	t.Helper()
+	g := NewWithT(t)
+
+	objs := make([]client.Object, 0, len(deployments))
+	for _, d := range deployments {
+		objs = append(objs, d)
+	}
+
+	cl, err := fakeclient.New(objs...)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	instance := componentApi.Dashboard{}
+	if token != "" {
+		instance.SetAnnotations(map[string]string{annotations.RestartRequested: token})
+	}
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &instance,
+	}
+
+	// the rendered resources never carry the restart token
+	for _, d := range deployments {
+		u, err := resources.ToUnstructured(newDeployment(d.Namespace, d.Name, "", true))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	return &rr
+}
+
+func restartTokens(rr *types.ReconciliationRequest) []string {
+	tokens := make([]string, 0, len(rr.Resources))
+	for i := range rr.Resources {
+		v, _, _ := unstructured.NestedString(rr.Resources[i].Object, "spec", "template", "metadata", "annotations", annotations.RestartToken)
+		tokens = append(tokens, v)
+	}
+
+	return tokens
+}
+
+func TestRestartAction(t *testing.T) {
+	ctx := context.Background()
+	ns := xid.New().String()
+
+	tests := map[string]struct {
+		token       string
+		deployments []*appsv1.Deployment
+		tokens      []string
+		reason      string
+	}{
+		"restart of the first deployment": {
+			token: "t2",
+			deployments: []*appsv1.Deployment{
+				newDeployment(ns, "first", "t1", true),
+				newDeployment(ns, "second", "t1", true),
+				newDeployment(ns, "third", "", true),
+			},
+			tokens: []string{"t2", "t1", ""},
+			reason: status.RestartInProgressReason,
+		},
+		"wait for the rollout of the first deployment": {
+			token: "t2",
+			deployments: []*appsv1.Deployment{
+				newDeployment(ns, "first", "t2", false),
+				newDeployment(ns, "second", "t1", true),
+				newDeployment(ns, "third", "", true),
+			},
+			tokens: []string{"t2", "t1", ""},
+			reason: status.RestartInProgressReason,
+		},
+		"restart of the next deployment": {
+			token: "t2",
+			deployments: []*appsv1.Deployment{
+				newDeployment(ns, "first", "t2", true),
+				newDeployment(ns, "second", "t1", true),
+				newDeployment(ns, "third", "", true),
+			},
+			tokens: []string{"t2", "t2", ""},
+			reason: status.RestartInProgressReason,
+		},
+		"restart completed": {
+			token: "t2",
+			deployments: []*appsv1.Deployment{
+				newDeployment(ns, "first", "t2", true),
+				newDeployment(ns, "second", "t2", true),
+				newDeployment(ns, "third", "t2", true),
+			},
+			tokens: []string{"t2", "t2", "t2"},
+			reason: status.RestartCompletedReason,
+		},
+		"tokens are retained when no restart is requested": {
+			deployments: []*appsv1.Deployment{
+				newDeployment(ns, "first", "t2", true),
+				newDeployment(ns, "second", "t1", true),
+				newDeployment(ns, "third", "", true),
+			},
+			tokens: []string{"t2", "t1", ""},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			rr := newRequest(t, tt.token, tt.deployments...)
+
+			err := restart.NewAction()(ctx, rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(restartTokens(rr)).Should(Equal(tt.tokens))
+
+			u, err := resources.ToUnstructured(rr.Instance)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			if tt.reason == "" {
+				g.Expect(u).Should(jq.Match(`.status.conditions == null`))
+				return
+			}
+
+			g.Expect(u).Should(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeRestarted, tt.reason),
+			)
+		})
+	}
+}
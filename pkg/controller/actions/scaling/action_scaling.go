@@ -0,0 +1,72 @@
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action applies the fixed number of replicas of the scaling configuration of a component, if any, to
+// the rendered Deployments of its controllers, as selected with WithDeployments. The Deployments are
+// annotated with annotations.ManagedReplicas, so that the deploy action does not preserve the replicas
+// found in the cluster.
+//
+// The controllers are leader-elected, so the additional replicas only provide high availability: they
+// are not autoscaled, as a HorizontalPodAutoscaler would add standbys rather than spread the load.
+//
+// The action must be executed after the resources are rendered and before they are deployed.
+type Action struct {
+	deployments []string
+}
+
+type ActionOpts func(*Action)
+
+// WithDeployments sets the names of the Deployments the scaling configuration applies to.
+func WithDeployments(names ...string) ActionOpts {
+	return func(a *Action) {
+		a.deployments = append(a.deployments, names...)
+	}
+}
+
+func (a *Action) run(_ context.Context, rr *types.ReconciliationRequest) error {
+	inst, ok := rr.Instance.(common.WithScaling)
+	if !ok || inst.GetScaling() == nil || inst.GetScaling().Replicas == nil {
+		return nil
+	}
+
+	replicas := int64(*inst.GetScaling().Replicas)
+
+	for i := range rr.Resources {
+		r := &rr.Resources[i]
+		if r.GroupVersionKind() != gvk.Deployment || !slices.Contains(a.deployments, r.GetName()) {
+			continue
+		}
+
+		resources.SetAnnotation(r, annotations.ManagedReplicas, "true")
+
+		if err := unstructured.SetNestedField(r.Object, replicas, "spec", "replicas"); err != nil {
+			return fmt.Errorf("failed to set replicas of deployment %s: %w", r.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,114 @@
+package scaling_test
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/scaling"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const controllerName = "kserve-controller-manager"
+
+func newDeployment(t *testing.T, name string) unstructured.Unstructured {
+	t.Helper()
+
+	d := appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Deployment.GroupVersion().String(),
+			Kind:       gvk.Deployment.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "opendatahub",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "manager"}},
+				},
+			},
+		},
+	}
+
+	u, err := resources.ToUnstructured(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return *u
+}
+
+func newRequest(t *testing.T, sc *common.Scaling) *types.ReconciliationRequest {
+	t.Helper()
+
+	instance := componentApi.Kserve{}
+	instance.Spec.Scaling = sc
+
+	return &types.ReconciliationRequest{
+		Instance: &instance,
+		Resources: []unstructured.Unstructured{
+			newDeployment(t, controllerName),
+			newDeployment(t, "odh-model-controller"),
+		},
+	}
+}
+
+func TestScalingActionReplicas(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	rr := newRequest(t, &common.Scaling{Replicas: ptr.To[int32](3)})
+
+	err := scaling.NewAction(scaling.WithDeployments(controllerName))(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rr.Resources).Should(HaveLen(2))
+	g.Expect(rr.Resources[0]).Should(And(
+		jq.Match(`.spec.replicas == 3`),
+		jq.Match(`.metadata.annotations."%s" == "true"`, annotations.ManagedReplicas),
+	))
+	g.Expect(rr.Resources[1]).Should(And(
+		jq.Match(`.spec.replicas == 1`),
+		jq.Match(`.metadata | has("annotations") | not`),
+	))
+}
+
+func TestScalingActionNoReplicas(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]*common.Scaling{
+		"no scaling":  nil,
+		"no replicas": {},
+	}
+
+	for name, sc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			rr := newRequest(t, sc)
+
+			err := scaling.NewAction(scaling.WithDeployments(controllerName))(ctx, rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			g.Expect(rr.Resources).Should(HaveEach(And(
+				jq.Match(`.spec.replicas == 1`),
+				jq.Match(`.metadata | has("annotations") | not`),
+			)))
+		})
+	}
+}
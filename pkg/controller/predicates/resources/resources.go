@@ -30,7 +30,9 @@ func (DeploymentPredicate) Update(e event.UpdateEvent) bool {
 
 	return oldDeployment.Generation != newDeployment.Generation ||
 		oldDeployment.Status.Replicas != newDeployment.Status.Replicas ||
-		oldDeployment.Status.ReadyReplicas != newDeployment.Status.ReadyReplicas
+		oldDeployment.Status.ReadyReplicas != newDeployment.Status.ReadyReplicas ||
+		oldDeployment.Status.UpdatedReplicas != newDeployment.Status.UpdatedReplicas ||
+		oldDeployment.Status.AvailableReplicas != newDeployment.Status.AvailableReplicas
 }
 
 func NewDeploymentPredicate() *DeploymentPredicate {
@@ -112,13 +112,11 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		if err := r.delete(ctx, res); err != nil {
 			return ctrl.Result{}, err
 		}
-	} else {
-		if err := r.apply(ctx, res); err != nil {
-			return ctrl.Result{}, err
-		}
+
+		return ctrl.Result{}, nil
 	}
 
-	return ctrl.Result{}, nil
+	return r.apply(ctx, res)
 }
 
 func (r *Reconciler[T]) delete(ctx context.Context, res client.Object) error {
@@ -162,17 +160,17 @@ func (r *Reconciler[T]) delete(ctx context.Context, res client.Object) error {
 	return nil
 }
 
-func (r *Reconciler[T]) apply(ctx context.Context, res client.Object) error {
+func (r *Reconciler[T]) apply(ctx context.Context, res client.Object) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 	l.Info("apply")
 
 	dscil := dsciv1.DSCInitializationList{}
 	if err := r.Client.List(ctx, &dscil); err != nil {
-		return err
+		return ctrl.Result{}, err
 	}
 
 	if len(dscil.Items) != 1 {
-		return errors.New("unable to find DSCInitialization")
+		return ctrl.Result{}, errors.New("unable to find DSCInitialization")
 	}
 
 	rr := types.ReconciliationRequest{
@@ -197,7 +195,7 @@ func (r *Reconciler[T]) apply(ctx context.Context, res client.Object) error {
 			se := odherrors.StopError{}
 			if !errors.As(err, &se) {
 				l.Error(err, "Failed to execute action", "action", action)
-				return err
+				return ctrl.Result{}, err
 			}
 
 			l.V(3).Info("detected stop marker", "action", action)
@@ -213,8 +211,8 @@ func (r *Reconciler[T]) apply(ctx context.Context, res client.Object) error {
 	)
 
 	if err != nil {
-		return client.IgnoreNotFound(err)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	return nil
+	return ctrl.Result{RequeueAfter: rr.RequeueAfter}, nil
 }
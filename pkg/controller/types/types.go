@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -90,6 +91,10 @@ type ReconciliationRequest struct {
 	//       replaced with a better way of describing resources and
 	//       their origin
 	Generated bool
+
+	// RequeueAfter, when set by an action, requests the instance to be reconciled again after the
+	// given duration even if nothing changes in the meantime.
+	RequeueAfter time.Duration
 }
 
 // AddResources adds one or more resources to the ReconciliationRequest's Resources slice.
@@ -0,0 +1,125 @@
+// Package externalsecrets builds the ExternalSecret resources of the External Secrets Operator, which
+// source the credentials of the platform from an external secret manager (e.g. Vault) when the
+// ExternalSecrets secret backend is configured in the DSCInitialization.
+package externalsecrets
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const (
+	defaultStoreKind       = "ClusterSecretStore"
+	defaultRefreshInterval = "1h"
+)
+
+var ErrNotInstalled = errors.New("the ExternalSecrets secret backend is configured but the External Secrets Operator is not installed")
+
+// Enabled returns true if the credentials are sourced from the External Secrets Operator.
+func Enabled(spec *dsciv1.SecretBackendSpec) bool {
+	return spec != nil && spec.Type == dsciv1.SecretBackendExternalSecrets && spec.ExternalSecrets != nil
+}
+
+// Key returns the remote key the credentials of the given Secret are read from.
+func Key(spec dsciv1.ExternalSecretsSpec, namespace string, name string) string {
+	return spec.KeyPrefix + namespace + "/" + name
+}
+
+// New returns an ExternalSecret synchronizing the given properties of the remote key to the Secret of the
+// same name, each property being stored under the key of the same name. The Secret is created with the
+// given type and labels, and is owned by the ExternalSecret.
+func New(
+	spec dsciv1.ExternalSecretsSpec,
+	name string,
+	namespace string,
+	key string,
+	secretType corev1.SecretType,
+	labels map[string]string,
+	properties ...string,
+) *unstructured.Unstructured {
+	kind := spec.StoreRef.Kind
+	if kind == "" {
+		kind = defaultStoreKind
+	}
+
+	refreshInterval := defaultRefreshInterval
+	if spec.RefreshInterval.Duration > 0 {
+		refreshInterval = spec.RefreshInterval.Duration.String()
+	}
+
+	templateLabels := make(map[string]any, len(labels))
+	for k, v := range labels {
+		templateLabels[k] = v
+	}
+
+	template := map[string]any{
+		"metadata": map[string]any{
+			"labels": templateLabels,
+		},
+	}
+
+	if secretType != "" {
+		template["type"] = string(secretType)
+	}
+
+	data := make([]any, 0, len(properties))
+	for _, p := range properties {
+		data = append(data, map[string]any{
+			"secretKey": p,
+			"remoteRef": map[string]any{
+				"key":      key,
+				"property": p,
+			},
+		})
+	}
+
+	es := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"refreshInterval": refreshInterval,
+			"secretStoreRef": map[string]any{
+				"name": spec.StoreRef.Name,
+				"kind": kind,
+			},
+			"target": map[string]any{
+				"name":           name,
+				"creationPolicy": "Owner",
+				"template":       template,
+			},
+			"data": data,
+		},
+	}}
+
+	es.SetGroupVersionKind(gvk.ExternalSecret)
+	es.SetName(name)
+	es.SetNamespace(namespace)
+	es.SetLabels(labels)
+
+	return &es
+}
+
+// Create creates the ExternalSecret, an existing one is left unchanged.
+func Create(ctx context.Context, cli client.Client, es *unstructured.Unstructured, metaOptions ...cluster.MetaOptions) error {
+	if err := cluster.ApplyMetaOptions(es, metaOptions...); err != nil {
+		return err
+	}
+
+	err := cli.Create(ctx, es)
+	switch {
+	case meta.IsNoMatchError(err):
+		return ErrNotInstalled
+	case k8serr.IsAlreadyExists(err):
+		return nil
+	default:
+		return err
+	}
+}
@@ -0,0 +1,75 @@
+package externalsecrets_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/externalsecrets"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEnabled(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(externalsecrets.Enabled(nil)).Should(BeFalse())
+	g.Expect(externalsecrets.Enabled(&dsciv1.SecretBackendSpec{Type: dsciv1.SecretBackendKubernetes})).Should(BeFalse())
+	g.Expect(externalsecrets.Enabled(&dsciv1.SecretBackendSpec{Type: dsciv1.SecretBackendExternalSecrets})).Should(BeFalse())
+	g.Expect(externalsecrets.Enabled(&dsciv1.SecretBackendSpec{
+		Type:            dsciv1.SecretBackendExternalSecrets,
+		ExternalSecrets: &dsciv1.ExternalSecretsSpec{StoreRef: dsciv1.SecretStoreRef{Name: "vault"}},
+	})).Should(BeTrue())
+}
+
+func TestNewCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cli := clientFake.NewClientBuilder().Build()
+	spec := dsciv1.ExternalSecretsSpec{
+		StoreRef:  dsciv1.SecretStoreRef{Name: "vault"},
+		KeyPrefix: "odh/",
+	}
+
+	newCertificate := func() *unstructured.Unstructured {
+		return externalsecrets.New(spec, "knative-serving-cert", "istio-system",
+			externalsecrets.Key(spec, "istio-system", "knative-serving-cert"), corev1.SecretTypeTLS, nil,
+			corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	g.Expect(externalsecrets.Create(ctx, cli, newCertificate())).Should(Succeed())
+	// an existing ExternalSecret is left unchanged
+	g.Expect(externalsecrets.Create(ctx, cli, newCertificate())).Should(Succeed())
+
+	found := unstructured.Unstructured{}
+	found.SetGroupVersionKind(gvk.ExternalSecret)
+	g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: "istio-system", Name: "knative-serving-cert"}, &found)).Should(Succeed())
+
+	refreshInterval, _, _ := unstructured.NestedString(found.Object, "spec", "refreshInterval")
+	g.Expect(refreshInterval).Should(Equal("1h"))
+
+	store, _, _ := unstructured.NestedStringMap(found.Object, "spec", "secretStoreRef")
+	g.Expect(store).Should(Equal(map[string]string{"name": "vault", "kind": "ClusterSecretStore"}))
+
+	secretType, _, _ := unstructured.NestedString(found.Object, "spec", "target", "template", "type")
+	g.Expect(secretType).Should(Equal("kubernetes.io/tls"))
+
+	data, _, _ := unstructured.NestedSlice(found.Object, "spec", "data")
+	g.Expect(data).Should(ConsistOf(
+		map[string]any{
+			"secretKey": "tls.crt",
+			"remoteRef": map[string]any{"key": "odh/istio-system/knative-serving-cert", "property": "tls.crt"},
+		},
+		map[string]any{
+			"secretKey": "tls.key",
+			"remoteRef": map[string]any{"key": "odh/istio-system/knative-serving-cert", "property": "tls.key"},
+		},
+	))
+}
@@ -3,6 +3,7 @@ package feature
 import (
 	"context"
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -112,6 +113,22 @@ func (fb *featureBuilder) Managed() *featureBuilder {
 	return fb
 }
 
+// Uncached applies the manifests of the feature without reading their resources from the cluster.
+//
+// It is meant for features creating resources which never need to be looked at again by the operator, e.g. Roles,
+// ServiceMeshMembers or Gateways. As the client of the manager reads unstructured objects through its cache, reading
+// them would otherwise start an informer, and keep all the instances of their kind in memory, for each kind of
+// resource the feature creates.
+func (fb *featureBuilder) Uncached() *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.uncached = true
+
+		return nil
+	})
+
+	return fb
+}
+
 // WithData adds data providers to the feature (implemented as Actions).
 // This way you can define what data should be loaded before the feature is applied.
 // This can be later used in templates and when creating resources programmatically.
@@ -174,6 +191,21 @@ func (fb *featureBuilder) PostConditions(postconditions ...Action) *featureBuild
 	return fb
 }
 
+// Timeout limits how long applying the feature can take, including waiting for its pre and post conditions.
+// When it expires, the feature fails with ErrConditionTimeout. If not set, DefaultTimeout applies.
+func (fb *featureBuilder) Timeout(timeout time.Duration) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid timeout %s for '%s' feature, it must be positive", timeout, fb.featureName)
+		}
+		f.timeout = timeout
+
+		return nil
+	})
+
+	return fb
+}
+
 // OnDelete allow to add cleanup hooks that are executed when the feature is going to be deleted.
 func (fb *featureBuilder) OnDelete(cleanups ...CleanupFunc) *featureBuilder {
 	fb.builders = append(fb.builders, func(f *Feature) error {
@@ -196,6 +228,7 @@ func (fb *featureBuilder) Create() (*Feature, error) {
 		Name:    fb.featureName,
 		Managed: fb.managed,
 		Enabled: alwaysEnabled,
+		timeout: DefaultTimeout,
 		Log:     log.Log.WithName("features").WithValues("feature", fb.featureName),
 		source:  &fb.source,
 		owner:   fb.owner,
@@ -17,6 +17,9 @@ import (
 const (
 	interval = 2 * time.Second
 	duration = 5 * time.Minute
+
+	// DefaultTimeout is how long applying a feature can take when no timeout is set in its definition.
+	DefaultTimeout = 10 * time.Minute
 )
 
 type MissingOperatorError struct {
@@ -35,6 +38,11 @@ func (e *MissingOperatorError) Unwrap() error {
 	return e.err
 }
 
+// Is makes the error match ErrMissingPrerequisiteOperator.
+func (e *MissingOperatorError) Is(target error) bool {
+	return target == ErrMissingPrerequisiteOperator
+}
+
 func (e *MissingOperatorError) Error() string {
 	return fmt.Sprintf("missing operator %q", e.operatorName)
 }
@@ -0,0 +1,84 @@
+package feature
+
+import (
+	"context"
+	"errors"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// Classes of the errors returned when applying or cleaning up features. They are matched with
+// errors.Is, so that callers can pick a requeue policy and a condition reason without parsing
+// the messages of the underlying errors.
+var (
+	// ErrMissingPrerequisiteOperator is returned when an operator the feature depends on is not installed.
+	ErrMissingPrerequisiteOperator = errors.New("missing prerequisite operator")
+	// ErrTemplateRender is returned when a manifest template cannot be parsed or executed with the
+	// data of the feature.
+	ErrTemplateRender = errors.New("template render error")
+	// ErrRBACDenied is returned when the operator is not allowed to access a resource of the feature.
+	ErrRBACDenied = errors.New("access denied")
+	// ErrConditionTimeout is returned when a pre or post condition is not met in time.
+	ErrConditionTimeout = errors.New("timed out waiting for condition")
+)
+
+// ErrOwnerDeleted is returned when applying a feature is aborted because its owner is being deleted.
+var ErrOwnerDeleted = errors.New("owner of the feature is being deleted")
+
+// classifiedError attaches a class to an error, leaving its message untouched.
+type classifiedError struct {
+	class error
+	err   error
+}
+
+func (e *classifiedError) Unwrap() []error {
+	return []error{e.class, e.err}
+}
+
+func (e *classifiedError) Error() string {
+	return e.err.Error()
+}
+
+// classify attaches the class of err to it, if it can be inferred and it is not already attached.
+func classify(err error) error {
+	if err == nil || errorClass(err) != nil {
+		return err
+	}
+
+	switch {
+	case k8serr.IsForbidden(err):
+		return &classifiedError{class: ErrRBACDenied, err: err}
+	case errors.Is(err, context.DeadlineExceeded):
+		return &classifiedError{class: ErrConditionTimeout, err: err}
+	default:
+		return err
+	}
+}
+
+func errorClass(err error) error {
+	for _, class := range []error{ErrMissingPrerequisiteOperator, ErrTemplateRender, ErrRBACDenied, ErrConditionTimeout} {
+		if errors.Is(err, class) {
+			return class
+		}
+	}
+
+	return nil
+}
+
+// ConditionReason returns the reason of the condition reporting err, or fallback if err has no class.
+func ConditionReason(err error, fallback string) string {
+	switch errorClass(err) {
+	case ErrMissingPrerequisiteOperator:
+		return status.MissingOperatorReason
+	case ErrTemplateRender:
+		return status.TemplateRenderFailedReason
+	case ErrRBACDenied:
+		return status.RBACDeniedReason
+	case ErrConditionTimeout:
+		return status.ConditionTimeoutReason
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,39 @@
+package feature_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature errors", func() {
+
+	It("should match missing operator errors as missing prerequisites", func() {
+		// given
+		err := fmt.Errorf("failed to find subscription: %w", feature.NewMissingOperatorError("servicemeshoperator", nil))
+
+		// then
+		Expect(err).To(MatchError(feature.ErrMissingPrerequisiteOperator))
+		Expect(feature.ConditionReason(err, status.CapabilityFailed)).To(Equal(status.MissingOperatorReason))
+	})
+
+	DescribeTable("should map error classes to condition reasons",
+		func(class error, reason string) {
+			err := fmt.Errorf("%w: failed applying feature: %w", class, errors.New("cause"))
+
+			Expect(feature.ConditionReason(err, status.CapabilityFailed)).To(Equal(reason))
+		},
+		Entry("template render", feature.ErrTemplateRender, status.TemplateRenderFailedReason),
+		Entry("RBAC denied", feature.ErrRBACDenied, status.RBACDeniedReason),
+		Entry("condition timeout", feature.ErrConditionTimeout, status.ConditionTimeoutReason),
+	)
+
+	It("should fall back to the given reason for unclassified errors", func() {
+		Expect(feature.ConditionReason(errors.New("cause"), status.CapabilityFailed)).To(Equal(status.CapabilityFailed))
+	})
+})
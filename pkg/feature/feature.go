@@ -2,11 +2,15 @@ package feature
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
@@ -50,6 +54,10 @@ type Feature struct {
 	data map[string]any
 
 	appliers []resource.Applier
+	uncached bool
+
+	deletionPolicy metav1.DeletionPropagation
+	timeout        time.Duration
 
 	cleanups          []CleanupFunc
 	clusterOperations []Action
@@ -95,12 +103,65 @@ func (f *Feature) Apply(ctx context.Context, cli client.Client) error {
 		return updateErr
 	}
 
-	applyErr := f.applyFeature(ctx, cli)
+	featureCtx, cancel := f.applyContext(ctx, cli)
+	defer cancel()
+
+	applyErr := classify(f.applyFeature(featureCtx, cli))
+	if applyErr != nil && errors.Is(context.Cause(featureCtx), ErrOwnerDeleted) {
+		f.Log.Info("aborted applying feature, its owner is being deleted")
+
+		return fmt.Errorf("%w: %w", ErrOwnerDeleted, applyErr)
+	}
+
 	_, reportErr := createFeatureTrackerStatusReporter(cli, f).ReportCondition(ctx, applyErr)
 
 	return multierror.Append(applyErr, reportErr).ErrorOrNil()
 }
 
+// applyContext derives the context the feature is applied with from the one of the reconciliation. It
+// expires after the timeout of the feature, and it is canceled as soon as the owner of the feature is
+// being deleted, so that long-running conditions do not hold back the deletion.
+func (f *Feature) applyContext(ctx context.Context, cli client.Client) (context.Context, context.CancelFunc) {
+	ctx, cancelTimeout := context.WithTimeout(ctx, f.timeout)
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	if owner, ok := f.owner.(client.Object); ok {
+		go func() {
+			_ = wait.PollUntilContextCancel(ctx, interval, false, func(ctx context.Context) (bool, error) {
+				if ownerDeleted(ctx, cli, owner) {
+					cancel(ErrOwnerDeleted)
+
+					return true, nil
+				}
+
+				return false, nil
+			})
+		}()
+	}
+
+	return ctx, func() {
+		cancel(nil)
+		cancelTimeout()
+	}
+}
+
+func ownerDeleted(ctx context.Context, cli client.Client, owner client.Object) bool {
+	current, ok := owner.DeepCopyObject().(client.Object)
+	if !ok {
+		return false
+	}
+
+	err := cli.Get(ctx, client.ObjectKeyFromObject(owner), current)
+	switch {
+	case k8serr.IsNotFound(err):
+		return true
+	case err != nil:
+		return false
+	default:
+		return !current.GetDeletionTimestamp().IsZero()
+	}
+}
+
 func (f *Feature) applyFeature(ctx context.Context, cli client.Client) error {
 	var multiErr *multierror.Error
 
@@ -126,7 +187,13 @@ func (f *Feature) applyFeature(ctx context.Context, cli client.Client) error {
 
 	for i := range f.appliers {
 		r := f.appliers[i]
-		if processErr := r.Apply(ctx, cli, f.data, DefaultMetaOptions(f)...); processErr != nil {
+
+		apply := r.Apply
+		if u, ok := r.(resource.UncachedApplier); ok && f.uncached {
+			apply = u.ApplyUncached
+		}
+
+		if processErr := apply(ctx, cli, f.data, DefaultMetaOptions(f)...); processErr != nil {
 			return &withConditionReasonError{reason: featurev1.ConditionReason.ApplyManifests, err: processErr}
 		}
 	}
@@ -151,7 +218,7 @@ func (f *Feature) Cleanup(ctx context.Context, cli client.Client) error {
 		cleanupErrors = multierror.Append(cleanupErrors, cleanupFunc(ctx, cli))
 	}
 
-	return cleanupErrors.ErrorOrNil()
+	return classify(cleanupErrors.ErrorOrNil())
 }
 
 func (f *Feature) addCleanup(cleanupFuncs ...CleanupFunc) {
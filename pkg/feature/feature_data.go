@@ -81,3 +81,21 @@ func (f *Feature) Set(key string, data any) error {
 
 	return nil
 }
+
+// Data returns the data of a feature applied in the given namespace once the given entries are populated,
+// i.e. the data its templates are executed with. It allows to execute the templates without a cluster, so the
+// entries must not fetch their value from it.
+func Data(targetNamespace string, entries ...Action) (map[string]any, error) {
+	f := Feature{Name: "data", TargetNamespace: targetNamespace}
+	if err := f.Set("TargetNamespace", targetNamespace); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if err := entry(context.Background(), nil, &f); err != nil {
+			return nil, err
+		}
+	}
+
+	return f.data, nil
+}
@@ -0,0 +1,205 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature context", func() {
+
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		dsci   *dsciv1.DSCInitialization
+	)
+
+	waitUntilCanceled := func(ctx context.Context, _ client.Client, _ *feature.Feature) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			WithObjects(objs...).
+			Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme = runtime.NewScheme()
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		dsci = &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+	})
+
+	It("should fail with a timeout when the feature is not applied in time", func() {
+		// given
+		slowFeature, err := feature.Define("slow-feature").
+			TargetNamespace("opendatahub").
+			OwnedBy(dsci).
+			Timeout(100 * time.Millisecond).
+			PostConditions(waitUntilCanceled).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		applyErr := slowFeature.Apply(ctx, newClient(dsci))
+
+		// then
+		Expect(applyErr).To(MatchError(feature.ErrConditionTimeout))
+		Expect(applyErr).ToNot(MatchError(feature.ErrOwnerDeleted))
+	})
+
+	It("should abort applying the feature when its owner is being deleted", func() {
+		// given
+		dsci.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		dsci.Finalizers = []string{"dscinitialization.opendatahub.io/finalizer"}
+
+		slowFeature, err := feature.Define("slow-feature").
+			TargetNamespace("opendatahub").
+			OwnedBy(dsci).
+			PostConditions(waitUntilCanceled).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		applyErr := slowFeature.Apply(ctx, newClient(dsci))
+
+		// then
+		Expect(applyErr).To(MatchError(feature.ErrOwnerDeleted))
+	})
+
+	It("should reject a non-positive timeout", func() {
+		_, err := feature.Define("invalid-timeout").
+			TargetNamespace("opendatahub").
+			Timeout(0).
+			Create()
+
+		Expect(err).To(MatchError(ContainSubstring("invalid timeout")))
+	})
+})
+
+var _ = Describe("Uncached feature", func() {
+
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		dsci   *dsciv1.DSCInitialization
+		fsys   fstest.MapFS
+		reads  []string
+	)
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			WithObjects(objs...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, cli client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*unstructured.Unstructured); ok {
+						reads = append(reads, key.Name)
+					}
+
+					return cli.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		reads = nil
+
+		scheme = runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		dsci = &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+
+		fsys = fstest.MapFS{
+			"resources/config.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: one-shot
+  namespace: opendatahub
+data:
+  key: desired
+`)},
+		}
+	})
+
+	It("should create the resources without reading them", func() {
+		// given
+		oneShot, err := feature.Define("one-shot").
+			TargetNamespace("opendatahub").
+			OwnedBy(dsci).
+			Manifests(manifest.Location(fsys).Include("resources")).
+			Uncached().
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		cli := newClient(dsci)
+
+		// when
+		Expect(oneShot.Apply(ctx, cli)).To(Succeed())
+
+		// then
+		Expect(reads).To(BeEmpty())
+
+		cm := corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "one-shot", Namespace: "opendatahub"}, &cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("key", "desired"))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+	})
+
+	It("should leave the existing resources untouched", func() {
+		// given
+		existing := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "one-shot", Namespace: "opendatahub"},
+			Data:       map[string]string{"key": "changed"},
+		}
+
+		oneShot, err := feature.Define("one-shot").
+			TargetNamespace("opendatahub").
+			OwnedBy(dsci).
+			Manifests(manifest.Location(fsys).Include("resources")).
+			Uncached().
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		cli := newClient(dsci, existing)
+
+		// when
+		Expect(oneShot.Apply(ctx, cli)).To(Succeed())
+
+		// then
+		Expect(reads).To(BeEmpty())
+
+		cm := corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(existing), &cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("key", "changed"))
+	})
+})
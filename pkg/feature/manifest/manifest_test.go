@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -85,6 +86,7 @@ data:
 
 			// then
 			Expect(err).Should(MatchError(ContainSubstring("at <.NotExistingKey>: map has no entry for key")))
+			Expect(err).Should(MatchError(feature.ErrTemplateRender))
 		})
 
 		It("should substitute target namespace in the templated manifest", func() {
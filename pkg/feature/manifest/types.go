@@ -15,6 +15,7 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 )
 
@@ -68,6 +69,8 @@ type Applier struct {
 	manifest *Manifest
 }
 
+var _ resource.UncachedApplier = (*Applier)(nil)
+
 func createApplier(manifest *Manifest) *Applier {
 	return &Applier{
 		manifest: manifest,
@@ -91,6 +94,21 @@ func (a Applier) Apply(ctx context.Context, cli client.Client, data map[string]a
 	return applierFunc(ctx, cli, objects, options...)
 }
 
+// ApplyUncached processes owned manifest and apply it to a cluster without reading the resources from it.
+func (a Applier) ApplyUncached(ctx context.Context, cli client.Client, data map[string]any, options ...cluster.MetaOptions) error {
+	objects, errProcess := a.manifest.Process(data)
+	if errProcess != nil {
+		return errProcess
+	}
+
+	// merge patches are only sent to the cluster
+	if a.manifest.patch {
+		return resource.Patch(ctx, cli, objects)
+	}
+
+	return resource.ApplyUncached(ctx, cli, objects, options...)
+}
+
 // Process allows any arbitrary struct to be passed and used while processing the content of the manifest.
 func (m *Manifest) Process(data any) ([]*unstructured.Unstructured, error) {
 	manifestFile, err := m.fsys.Open(m.path)
@@ -112,12 +130,12 @@ func (m *Manifest) Process(data any) ([]*unstructured.Unstructured, error) {
 			Option("missingkey=error").
 			Parse(resources)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse template: %w", err)
+			return nil, fmt.Errorf("%w: failed to parse %s: %w", feature.ErrTemplateRender, m.path, err)
 		}
 
 		var buffer bytes.Buffer
 		if err := tmpl.Execute(&buffer, data); err != nil {
-			return nil, fmt.Errorf("failed to execute template: %w", err)
+			return nil, fmt.Errorf("%w: failed to execute %s: %w", feature.ErrTemplateRender, m.path, err)
 		}
 
 		resources = buffer.String()
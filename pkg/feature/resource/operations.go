@@ -50,6 +50,36 @@ func Apply(ctx context.Context, cli client.Client, objects []*unstructured.Unstr
 	return nil
 }
 
+// ApplyUncached applies the objects like Apply, but without reading them from the cluster first, so that a client
+// backed by a cache does not start an informer for their kinds. Unmanaged objects are created unless they already
+// exist, managed ones are applied server-side, which also creates them when they are missing.
+func ApplyUncached(ctx context.Context, cli client.Client, objects []*unstructured.Unstructured, metaOptions ...cluster.MetaOptions) error {
+	for _, source := range objects {
+		for _, opt := range metaOptions {
+			if err := opt(source); err != nil {
+				return err
+			}
+		}
+
+		name := source.GetName()
+		namespace := source.GetNamespace()
+
+		if shouldReconcile(source) {
+			if errUpdate := patchUsingApplyStrategy(ctx, cli, source, source.DeepCopy()); errUpdate != nil {
+				return fmt.Errorf("failed to reconcile resource %s/%s: %w", namespace, name, errUpdate)
+			}
+
+			continue
+		}
+
+		if errCreate := cli.Create(ctx, source.DeepCopy()); client.IgnoreAlreadyExists(errCreate) != nil {
+			return fmt.Errorf("failed to create source %s/%s: %w", namespace, name, errCreate)
+		}
+	}
+
+	return nil
+}
+
 func Patch(ctx context.Context, cli client.Client, patches []*unstructured.Unstructured) error {
 	for _, patch := range patches {
 		if errPatch := patchUsingMergeStrategy(ctx, cli, patch); errPatch != nil {
@@ -13,6 +13,12 @@ type Applier interface {
 	Apply(ctx context.Context, cli client.Client, data map[string]any, options ...cluster.MetaOptions) error
 }
 
+// UncachedApplier is implemented by the appliers which can apply their set of resources without reading them
+// from the cluster, see ApplyUncached.
+type UncachedApplier interface {
+	ApplyUncached(ctx context.Context, cli client.Client, data map[string]any, options ...cluster.MetaOptions) error
+}
+
 // Creator is an interface that allows to create a set of resources to be applied.
 type Creator interface {
 	Create() ([]Applier, error)
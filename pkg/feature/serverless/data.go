@@ -6,6 +6,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
@@ -18,6 +19,7 @@ const (
 	servingKey              = "Serving"
 	certificateKey          = "KnativeCertificateSecret"
 	knativeIngressDomainKey = "KnativeIngressDomain"
+	secretBackendKey        = "SecretBackend"
 )
 
 // FeatureData is a convention to simplify how the data for the Serverless features is Defined and accessed.
@@ -25,6 +27,7 @@ var FeatureData = struct {
 	Serving         feature.DataDefinition[infrav1.ServingSpec, infrav1.ServingSpec]
 	CertificateName feature.DataDefinition[infrav1.ServingSpec, string]
 	IngressDomain   feature.DataDefinition[infrav1.ServingSpec, string]
+	SecretBackend   feature.DataDefinition[dsciv1.DSCInitializationSpec, *dsciv1.SecretBackendSpec]
 }{
 	Serving: feature.DataDefinition[infrav1.ServingSpec, infrav1.ServingSpec]{
 		Define: func(source *infrav1.ServingSpec) feature.DataEntry[infrav1.ServingSpec] {
@@ -53,6 +56,15 @@ var FeatureData = struct {
 		},
 		Extract: feature.ExtractEntry[string](knativeIngressDomainKey),
 	},
+	SecretBackend: feature.DataDefinition[dsciv1.DSCInitializationSpec, *dsciv1.SecretBackendSpec]{
+		Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[*dsciv1.SecretBackendSpec] {
+			return feature.DataEntry[*dsciv1.SecretBackendSpec]{
+				Key:   secretBackendKey,
+				Value: provider.ValueOf(source.SecretBackend).Get,
+			}
+		},
+		Extract: feature.ExtractEntry[*dsciv1.SecretBackendSpec](secretBackendKey),
+	},
 }
 
 func knativeDomain(ctx context.Context, c client.Client) (string, error) {
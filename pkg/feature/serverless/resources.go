@@ -3,10 +3,13 @@ package serverless
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/externalsecrets"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
 )
@@ -19,6 +22,20 @@ func ServingCertificateResource(ctx context.Context, cli client.Client, f *featu
 
 	switch secretData.Type {
 	case infrav1.SelfSigned:
+		// the certificate is sourced from the secret manager rather than generated in the cluster
+		if externalsecrets.Enabled(secretData.Backend) {
+			spec := *secretData.Backend.ExternalSecrets
+			es := externalsecrets.New(spec,
+				secretData.Name,
+				secretData.Namespace,
+				externalsecrets.Key(spec, secretData.Namespace, secretData.Name),
+				corev1.SecretTypeTLS,
+				nil,
+				corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+
+			return externalsecrets.Create(ctx, cli, es, feature.OwnedBy(f))
+		}
+
 		return cluster.CreateSelfSignedCertificate(ctx, cli,
 			secretData.Name,
 			secretData.Domain,
@@ -36,6 +53,7 @@ type secretParams struct {
 	Namespace string
 	Domain    string
 	Type      infrav1.CertType
+	Backend   *dsciv1.SecretBackendSpec
 }
 
 func getSecretParams(f *feature.Feature) (*secretParams, error) {
@@ -59,6 +77,12 @@ func getSecretParams(f *feature.Feature) (*secretParams, error) {
 		return nil, err
 	}
 
+	if backend, err := FeatureData.SecretBackend.Extract(f); err == nil {
+		result.Backend = backend
+	} else {
+		return nil, err
+	}
+
 	if controlPlane, err := servicemesh.FeatureData.ControlPlane.Extract(f); err == nil {
 		result.Namespace = controlPlane.Namespace
 	} else {
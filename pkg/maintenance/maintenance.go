@@ -0,0 +1,143 @@
+// Package maintenance evaluates the maintenance windows configured in the DSCInitialization, which
+// restrict when changes to the components and capabilities are rolled out.
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	// the operator image does not ship the time zone database
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+)
+
+const (
+	// MaxWindowDuration is the maximum duration of a maintenance window.
+	MaxWindowDuration = 7 * 24 * time.Hour
+	// RecheckPeriod bounds the time between two evaluations of the windows while changes are deferred,
+	// so that updates to the windows are taken into account even if nothing else changes.
+	RecheckPeriod = 15 * time.Minute
+)
+
+// Names of the capabilities of the DSCInitialization which can be targeted by a maintenance window, in
+// addition to the component and service names.
+const (
+	ServiceMeshCapability = "servicemesh"
+)
+
+// State is the state of the maintenance windows of a target at a point in time.
+type State struct {
+	// Restricted is true when at least one window applies to the target, false when changes can be
+	// rolled out at any time.
+	Restricted bool
+	// Open is true when changes can be rolled out.
+	Open bool
+	// Until is the time at which the current window closes, when restricted and open.
+	Until time.Time
+	// Next is the time at which the next window opens, when restricted and closed.
+	Next time.Time
+}
+
+// Evaluate returns the state of the maintenance windows of the target at the given time. An error is
+// returned if any of the windows that apply to the target is invalid.
+func Evaluate(spec *dsciv1.MaintenanceSpec, target string, now time.Time) (State, error) {
+	state := State{Open: true}
+	if spec == nil {
+		return state, nil
+	}
+
+	for i, w := range spec.Windows {
+		if len(w.Targets) > 0 && !slices.Contains(w.Targets, target) {
+			continue
+		}
+
+		if !state.Restricted {
+			state = State{Restricted: true}
+		}
+
+		open, until, next, err := evaluateWindow(w, now)
+		if err != nil {
+			return State{}, fmt.Errorf("invalid maintenance window %d: %w", i, err)
+		}
+
+		if open {
+			state.Open = true
+			if until.After(state.Until) {
+				state.Until = until
+			}
+		}
+
+		if state.Next.IsZero() || next.Before(state.Next) {
+			state.Next = next
+		}
+	}
+
+	if state.Open {
+		state.Next = time.Time{}
+	}
+
+	return state, nil
+}
+
+// parser accepts the standard `<minute> <hour> <day of month> <month> <day of week>` cron expressions,
+// the time zone is set by the window rather than in the expression.
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+var errNeverScheduled = errors.New("schedule never matches")
+
+// ParseSchedule parses the cron expression of a maintenance window.
+func ParseSchedule(expr string) (cron.Schedule, error) {
+	schedule, err := parser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+	}
+
+	return schedule, nil
+}
+
+// evaluateWindow returns whether the window is open at now and until when, and the next time it opens.
+func evaluateWindow(w dsciv1.MaintenanceWindow, now time.Time) (bool, time.Time, time.Time, error) {
+	schedule, err := ParseSchedule(w.Schedule)
+	if err != nil {
+		return false, time.Time{}, time.Time{}, err
+	}
+
+	duration := w.Duration.Duration
+	if duration <= 0 || duration > MaxWindowDuration {
+		return false, time.Time{}, time.Time{}, fmt.Errorf("duration %s is not in the (0, %s] range", duration, MaxWindowDuration)
+	}
+
+	loc := time.UTC
+	if w.TimeZone != "" {
+		loc, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return false, time.Time{}, time.Time{}, fmt.Errorf("unknown time zone %q: %w", w.TimeZone, err)
+		}
+	}
+
+	now = now.In(loc)
+
+	// the schedule gives up after five years, e.g. for the 31st of February
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return false, time.Time{}, time.Time{}, errNeverScheduled
+	}
+
+	// the window is open if it has started in the last duration, the latest start is the one that
+	// keeps it open the longest
+	var start time.Time
+	for t := schedule.Next(now.Add(-duration)); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		start = t
+	}
+
+	if start.IsZero() {
+		return false, time.Time{}, next, nil
+	}
+
+	return true, start.Add(duration), next, nil
+}
@@ -0,0 +1,155 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScheduleNext(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2024, time.May, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		schedule string
+		next     time.Time
+	}{
+		"every minute":          {schedule: "* * * * *", next: now.Add(time.Minute)},
+		"every quarter of hour": {schedule: "*/15 * * * *", next: time.Date(2024, time.May, 15, 10, 45, 0, 0, time.UTC)},
+		"later today":           {schedule: "0 22 * * *", next: time.Date(2024, time.May, 15, 22, 0, 0, 0, time.UTC)},
+		"next saturday":         {schedule: "0 22 * * SAT", next: time.Date(2024, time.May, 18, 22, 0, 0, 0, time.UTC)},
+		"weekdays range":        {schedule: "0 9 * * mon-fri", next: time.Date(2024, time.May, 16, 9, 0, 0, 0, time.UTC)},
+		"first of next month":   {schedule: "0 0 1 * *", next: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		"day of month or week":  {schedule: "0 0 20 * MON", next: time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC)},
+		"list of months":        {schedule: "0 0 1 JAN,JUL *", next: time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := maintenance.ParseSchedule(tt.schedule)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			g.Expect(s.Next(now)).Should(BeTemporally("==", tt.next))
+		})
+	}
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "* * * * FOO", "@daily"} {
+		t.Run(expr, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := maintenance.ParseSchedule(expr)
+			g.Expect(err).Should(HaveOccurred())
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// a Saturday
+	now := time.Date(2024, time.May, 18, 23, 0, 0, 0, time.UTC)
+
+	window := func(schedule string, duration time.Duration, targets ...string) dsciv1.MaintenanceWindow {
+		return dsciv1.MaintenanceWindow{
+			Schedule: schedule,
+			Duration: metav1.Duration{Duration: duration},
+			Targets:  targets,
+		}
+	}
+
+	tests := map[string]struct {
+		spec  *dsciv1.MaintenanceSpec
+		state maintenance.State
+	}{
+		"no maintenance": {
+			state: maintenance.State{Open: true},
+		},
+		"window for other targets": {
+			spec: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{
+				window("0 2 * * SUN", time.Hour, "kserve"),
+			}},
+			state: maintenance.State{Open: true},
+		},
+		"open window": {
+			spec: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{
+				window("0 22 * * SAT", 2*time.Hour),
+			}},
+			state: maintenance.State{Restricted: true, Open: true, Until: time.Date(2024, time.May, 19, 0, 0, 0, 0, time.UTC)},
+		},
+		"latest start of overlapping windows": {
+			spec: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{
+				window("*/15 * * * *", maintenance.MaxWindowDuration),
+			}},
+			state: maintenance.State{Restricted: true, Open: true, Until: now.Add(maintenance.MaxWindowDuration)},
+		},
+		"closed window": {
+			spec: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{
+				window("0 2 * * SUN", time.Hour, "dashboard"),
+			}},
+			state: maintenance.State{Restricted: true, Next: time.Date(2024, time.May, 19, 2, 0, 0, 0, time.UTC)},
+		},
+		"earliest of the closed windows": {
+			spec: &dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{
+				window("0 2 * * SUN", time.Hour),
+				window("30 23 * * *", time.Hour, "dashboard"),
+			}},
+			state: maintenance.State{Restricted: true, Next: time.Date(2024, time.May, 18, 23, 30, 0, 0, time.UTC)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			state, err := maintenance.Evaluate(tt.spec, "dashboard", now)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(state.Restricted).Should(Equal(tt.state.Restricted))
+			g.Expect(state.Open).Should(Equal(tt.state.Open))
+			g.Expect(state.Until).Should(BeTemporally("==", tt.state.Until))
+			g.Expect(state.Next).Should(BeTemporally("==", tt.state.Next))
+		})
+	}
+}
+
+func TestEvaluateTimeZone(t *testing.T) {
+	g := NewWithT(t)
+
+	// 22:00 in Rome during daylight saving time
+	now := time.Date(2024, time.May, 18, 20, 0, 0, 0, time.UTC)
+
+	state, err := maintenance.Evaluate(&dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{{
+		Schedule: "0 22 * * SAT",
+		Duration: metav1.Duration{Duration: time.Hour},
+		TimeZone: "Europe/Rome",
+	}}}, "dashboard", now)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(state.Open).Should(BeTrue())
+	g.Expect(state.Until).Should(BeTemporally("==", now.Add(time.Hour)))
+}
+
+func TestEvaluateInvalidWindow(t *testing.T) {
+	invalid := map[string]dsciv1.MaintenanceWindow{
+		"schedule":  {Schedule: "0 22 * *", Duration: metav1.Duration{Duration: time.Hour}},
+		"duration":  {Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 8 * 24 * time.Hour}},
+		"time zone": {Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+		"never":     {Schedule: "0 0 31 FEB *", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+
+	for name, w := range invalid {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := maintenance.Evaluate(&dsciv1.MaintenanceSpec{Windows: []dsciv1.MaintenanceWindow{w}}, "dashboard", time.Now())
+			g.Expect(err).Should(HaveOccurred())
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Package overlays provides a file system layering the templates specific to a platform flavor over
+// the templates shared by all of them, so that the differences between flavors are expressed in the
+// templates rather than in the code rendering them.
+//
+// The templates are organized as follows:
+//
+//	<root>/base/<path>                  templates used unless overridden
+//	<root>/overlays/<flavor>/<path>     templates replacing the base ones on the given flavor
+//
+// and are referenced as <root>/<path>, regardless of the flavor.
+package overlays
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+const (
+	baseDir     = "base"
+	overlaysDir = "overlays"
+)
+
+// FS resolves the templates of the flavor of the cluster. It implements fs.FS and fs.ReadDirFS, the
+// entries of a directory are the union of the base and overlay ones.
+type FS struct {
+	fsys   fs.FS
+	root   string
+	flavor func() cluster.Flavor
+	data   func() (any, error)
+}
+
+type Opts func(*FS)
+
+// WithFlavor sets the flavor of the overlay to use instead of the one detected on startup.
+func WithFlavor(flavor cluster.Flavor) Opts {
+	return func(o *FS) {
+		o.flavor = func() cluster.Flavor { return flavor }
+	}
+}
+
+// WithData sets the representative data the templates are executed with by Execute, i.e. data as provided
+// when they are rendered on reconciliation.
+func WithData(data func() (any, error)) Opts {
+	return func(o *FS) {
+		o.data = data
+	}
+}
+
+// registry holds the file systems created by the packages embedding templates, so that they are all
+// validated on startup.
+var registry []*FS
+
+// New returns the file system resolving the templates stored in the root directory of fsys. The file
+// system is validated by ValidateAll.
+func New(fsys fs.FS, root string, opts ...Opts) *FS {
+	o := FS{
+		fsys:   fsys,
+		root:   root,
+		flavor: cluster.GetFlavor,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	registry = append(registry, &o)
+
+	return &o
+}
+
+// ValidateAll validates all the file systems returned by New.
+func ValidateAll() error {
+	errs := make([]error, 0, len(registry))
+	for _, o := range registry {
+		if err := o.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid templates in %s: %w", o.root, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// All returns the file systems returned by New.
+func All() []*FS {
+	return slices.Clone(registry)
+}
+
+func (o *FS) Open(name string) (fs.File, error) {
+	candidates, err := o.resolve("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		f, err := o.fsys.Open(candidate)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		return f, err
+	}
+
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (o *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	candidates, err := o.resolve("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	entries := map[string]fs.DirEntry{}
+
+	// the base entries are read last, so that the overlay ones take precedence
+	for _, candidate := range candidates {
+		dirEntries, err := fs.ReadDir(o.fsys, candidate)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		found = true
+		for _, e := range dirEntries {
+			if _, ok := entries[e.Name()]; !ok {
+				entries[e.Name()] = e
+			}
+		}
+	}
+
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	result := make([]fs.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, e)
+	}
+
+	slices.SortFunc(result, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return result, nil
+}
+
+// resolve returns the paths name may be stored at in the underlying file system, by precedence.
+func (o *FS) resolve(op string, name string) ([]string, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	var rel string
+	switch {
+	case name == o.root:
+		rel = "."
+	case strings.HasPrefix(name, o.root+"/"):
+		rel = strings.TrimPrefix(name, o.root+"/")
+	default:
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+
+	candidates := make([]string, 0, 2)
+	if flavor := o.flavor(); flavor != "" {
+		candidates = append(candidates, path.Join(o.root, overlaysDir, string(flavor), rel))
+	}
+
+	return append(candidates, path.Join(o.root, baseDir, rel)), nil
+}
+
+// Validate checks that the overlays are complete and consistent with the base templates: the base
+// directory must exist, each overlay must be named after a supported flavor, and every file of an
+// overlay must replace a base file, so that a misplaced or misspelled override is not silently
+// ignored.
+func (o *FS) Validate() error {
+	base := path.Join(o.root, baseDir)
+	if _, err := fs.Stat(o.fsys, base); err != nil {
+		return fmt.Errorf("missing base templates in %s: %w", base, err)
+	}
+
+	overlays, err := fs.ReadDir(o.fsys, path.Join(o.root, overlaysDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, overlay := range overlays {
+		if !overlay.IsDir() || !slices.Contains(cluster.Flavors, cluster.Flavor(overlay.Name())) {
+			errs = append(errs, fmt.Errorf("overlay %q does not match any of the supported flavors %v", overlay.Name(), cluster.Flavors))
+			continue
+		}
+
+		dir := path.Join(o.root, overlaysDir, overlay.Name())
+
+		err := fs.WalkDir(o.fsys, dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			rel := strings.TrimPrefix(p, dir+"/")
+
+			info, err := fs.Stat(o.fsys, path.Join(base, rel))
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				errs = append(errs, fmt.Errorf("overlay %s: %s does not replace any base template", overlay.Name(), rel))
+			case err != nil:
+				return err
+			case info.IsDir():
+				errs = append(errs, fmt.Errorf("overlay %s: %s replaces a base directory", overlay.Name(), rel))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Parse parses the templates of the file system, i.e. the files with a .tmpl. extension, as resolved on
+// each of the supported flavors.
+func (o *FS) Parse() error {
+	return o.walkTemplates(func(flavor cluster.Flavor, name string, content []byte) error {
+		if _, err := template.New(name).Option("missingkey=error").Parse(string(content)); err != nil {
+			return fmt.Errorf("flavor %s: %w", flavor, err)
+		}
+
+		return nil
+	})
+}
+
+// Execute executes the templates of the file system with the data set by WithData, as resolved on each
+// of the supported flavors, so that a template referring to data which is not provided is reported
+// without running on that flavor.
+func (o *FS) Execute() error {
+	if o.data == nil {
+		return errors.New("no representative data to execute the templates with")
+	}
+
+	data, err := o.data()
+	if err != nil {
+		return fmt.Errorf("failed to build the representative data of the templates: %w", err)
+	}
+
+	return o.walkTemplates(func(flavor cluster.Flavor, name string, content []byte) error {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("flavor %s: %w", flavor, err)
+		}
+
+		if err := tmpl.Execute(io.Discard, data); err != nil {
+			return fmt.Errorf("flavor %s: %w", flavor, err)
+		}
+
+		return nil
+	})
+}
+
+// walkTemplates calls fn for each template of the file system, as resolved on each of the supported
+// flavors, and joins the errors it returns.
+func (o *FS) walkTemplates(fn func(flavor cluster.Flavor, name string, content []byte) error) error {
+	var errs []error
+
+	for _, flavor := range cluster.Flavors {
+		view := FS{fsys: o.fsys, root: o.root, flavor: func() cluster.Flavor { return flavor }}
+
+		err := fs.WalkDir(&view, o.root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.Contains(path.Base(p), ".tmpl.") {
+				return err
+			}
+
+			content, err := fs.ReadFile(&view, p)
+			if err != nil {
+				return err
+			}
+
+			if err := fn(flavor, p, content); err != nil {
+				errs = append(errs, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
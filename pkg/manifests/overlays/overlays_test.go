@@ -0,0 +1,176 @@
+package overlays_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/overlays"
+
+	. "github.com/onsi/gomega"
+)
+
+func newFS() fstest.MapFS {
+	return fstest.MapFS{
+		"resources/base/servicemesh/smcp.tmpl.yaml":                    {Data: []byte("base")},
+		"resources/base/servicemesh/smm.tmpl.yaml":                     {Data: []byte("base")},
+		"resources/base/authorino/auth.tmpl.yaml":                      {Data: []byte("base")},
+		"resources/overlays/openshift-fips/servicemesh/smcp.tmpl.yaml": {Data: []byte("fips")},
+		"resources/overlays/hcp/authorino/auth.tmpl.yaml":              {Data: []byte("hcp")},
+		"resources/overlays/kubernetes/servicemesh/smm.tmpl.yaml":      {Data: []byte("kubernetes")},
+		"resources/overlays/kubernetes/servicemesh/smcp.tmpl.yaml":     {Data: []byte("kubernetes")},
+	}
+}
+
+func TestOpen(t *testing.T) {
+	tests := map[string]struct {
+		flavor cluster.Flavor
+		path   string
+		data   string
+	}{
+		"undetected flavor":      {path: "resources/servicemesh/smcp.tmpl.yaml", data: "base"},
+		"flavor without overlay": {flavor: cluster.OpenShift, path: "resources/servicemesh/smcp.tmpl.yaml", data: "base"},
+		"overridden template":    {flavor: cluster.OpenShiftFIPS, path: "resources/servicemesh/smcp.tmpl.yaml", data: "fips"},
+		"inherited template":     {flavor: cluster.OpenShiftFIPS, path: "resources/servicemesh/smm.tmpl.yaml", data: "base"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			data, err := fs.ReadFile(overlays.New(newFS(), "resources", overlays.WithFlavor(tt.flavor)), tt.path)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(string(data)).Should(Equal(tt.data))
+		})
+	}
+}
+
+func TestOpenOutsideOfRoot(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := fs.ReadFile(overlays.New(newFS(), "resources"), "resources/base/servicemesh/smcp.tmpl.yaml")
+	g.Expect(err).Should(MatchError(fs.ErrNotExist))
+
+	_, err = fs.ReadFile(overlays.New(newFS(), "resources"), "other/smcp.tmpl.yaml")
+	g.Expect(err).Should(MatchError(fs.ErrNotExist))
+}
+
+func TestWalkDir(t *testing.T) {
+	g := NewWithT(t)
+
+	fsys := overlays.New(newFS(), "resources", overlays.WithFlavor(cluster.HostedControlPlane))
+
+	var files []string
+	err := fs.WalkDir(fsys, "resources", func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			files = append(files, path)
+		}
+
+		return err
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(files).Should(Equal([]string{
+		"resources/authorino/auth.tmpl.yaml",
+		"resources/servicemesh/smcp.tmpl.yaml",
+		"resources/servicemesh/smm.tmpl.yaml",
+	}))
+
+	data, err := fs.ReadFile(fsys, "resources/authorino/auth.tmpl.yaml")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(data)).Should(Equal("hcp"))
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		files fstest.MapFS
+		err   string
+	}{
+		"missing base": {
+			files: fstest.MapFS{"resources/overlays/hcp/smcp.tmpl.yaml": {}},
+			err:   "missing base templates",
+		},
+		"unknown flavor": {
+			files: fstest.MapFS{
+				"resources/base/smcp.tmpl.yaml":          {},
+				"resources/overlays/fips/smcp.tmpl.yaml": {},
+			},
+			err: `overlay "fips" does not match any of the supported flavors`,
+		},
+		"orphan overlay": {
+			files: fstest.MapFS{
+				"resources/base/servicemesh/smcp.tmpl.yaml":         {},
+				"resources/overlays/hcp/servicemesh/smmr.tmpl.yaml": {},
+			},
+			err: "servicemesh/smmr.tmpl.yaml does not replace any base template",
+		},
+		"overlay replacing a directory": {
+			files: fstest.MapFS{
+				"resources/base/servicemesh/smcp.tmpl.yaml": {},
+				"resources/overlays/hcp/servicemesh":        {},
+			},
+			err: "servicemesh replaces a base directory",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := overlays.New(tt.files, "resources").Validate()
+			g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+
+	t.Run("valid overlays", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(overlays.New(newFS(), "resources").Validate()).Should(Succeed())
+	})
+}
+
+func TestParse(t *testing.T) {
+	g := NewWithT(t)
+
+	files := fstest.MapFS{
+		"resources/base/servicemesh/smcp.tmpl.yaml":         {Data: []byte("name: {{ .Name }}")},
+		"resources/base/servicemesh/README.md":              {Data: []byte("{{ not a template")},
+		"resources/overlays/hcp/servicemesh/smcp.tmpl.yaml": {Data: []byte("name: {{ .Name ")},
+	}
+
+	err := overlays.New(files, "resources").Parse()
+	g.Expect(err).Should(MatchError(And(
+		ContainSubstring("flavor hcp"),
+		ContainSubstring("resources/servicemesh/smcp.tmpl.yaml"),
+	)))
+	g.Expect(err.Error()).ShouldNot(ContainSubstring("flavor openshift:"))
+	g.Expect(err.Error()).ShouldNot(ContainSubstring("README.md"))
+
+	g.Expect(overlays.New(newFS(), "resources").Parse()).Should(Succeed())
+}
+
+func TestExecute(t *testing.T) {
+	g := NewWithT(t)
+
+	files := fstest.MapFS{
+		"resources/base/servicemesh/smcp.tmpl.yaml":                    {Data: []byte("name: {{ .ControlPlane.Name }}")},
+		"resources/overlays/openshift-fips/servicemesh/smcp.tmpl.yaml": {Data: []byte("name: {{ .ControlPlane.Name }}-{{ .Flavor }}")},
+	}
+
+	data := func() (any, error) {
+		return map[string]any{"ControlPlane": map[string]any{"Name": "data-science-smcp"}}, nil
+	}
+
+	err := overlays.New(files, "resources", overlays.WithData(data)).Execute()
+	g.Expect(err).Should(MatchError(And(
+		ContainSubstring("flavor openshift-fips"),
+		ContainSubstring(`map has no entry for key "Flavor"`),
+	)))
+	g.Expect(err.Error()).ShouldNot(ContainSubstring("flavor openshift:"))
+
+	g.Expect(overlays.New(files, "resources").Execute()).Should(MatchError(ContainSubstring("no representative data")))
+
+	delete(files, "resources/overlays/openshift-fips/servicemesh/smcp.tmpl.yaml")
+	g.Expect(overlays.New(files, "resources", overlays.WithData(data)).Execute()).Should(Succeed())
+}
@@ -17,6 +17,39 @@ const (
 // ManagementStateAnnotation set on Component CR only, to show which ManagementState value if defined in DSC for the component.
 const ManagementStateAnnotation = "component.opendatahub.io/management-state"
 
+// AdoptionMode set on Component CR only, to define how resources of the component which already exist in the cluster,
+// but were not deployed by the operator, are handled. See AdoptionModeAdopt.
+const AdoptionMode = "component.opendatahub.io/adoption-mode"
+
+// AdoptionModeAdopt makes the operator take ownership of pre-existing resources instead of reporting them as conflicts.
+const AdoptionModeAdopt = "Adopt"
+
+// RestartRequested set on the DataScienceCluster, or on a Component CR, requests a rolling restart of the
+// workloads of the components. The value is an opaque token, e.g. a timestamp: setting a different value
+// requests a new restart.
+const RestartRequested = "component.opendatahub.io/restart-requested"
+
+// RestartToken set on the pod template of the Deployments of a component, holds the value of the last
+// RestartRequested annotation the Deployment has been restarted for.
+const RestartToken = "component.opendatahub.io/restart-token"
+
+// ManagedReplicas set to "true" on a rendered Deployment makes its replicas managed by the platform, as
+// configured in the scaling of the component, instead of preserving the value found in the cluster.
+const ManagedReplicas = "component.opendatahub.io/managed-replicas"
+
+// RecommendedAccelerators set on serving runtimes and training jobs, lists as a JSON array the identifiers
+// of the accelerators the workload is meant to run on (e.g. ["nvidia.com/gpu"]).
+const RecommendedAccelerators = "opendatahub.io/recommended-accelerators"
+
+// Display name and description of a namespace, or of a data connection, shown in the console and in the dashboard.
+const (
+	DisplayName = "openshift.io/display-name"
+	Description = "openshift.io/description"
+)
+
+// ConnectionType set on a data connection Secret gives the type of storage it connects to, e.g. s3.
+const ConnectionType = "opendatahub.io/connection-type"
+
 const (
 	PlatformVersion    = "platform.opendatahub.io/version"
 	PlatformType       = "platform.opendatahub.io/type"
@@ -24,3 +57,7 @@ const (
 	InstanceName       = "platform.opendatahub.io/instance.name"
 	InstanceUID        = "platform.opendatahub.io/instance.uid"
 )
+
+// AllowManualChanges set to "true" on a FeatureTracker lifts the webhook protection, allowing it to be
+// changed or deleted by users other than the operator.
+const AllowManualChanges = "features.opendatahub.io/allow-manual-changes"
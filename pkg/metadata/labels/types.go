@@ -10,6 +10,22 @@ const (
 	True              = "true"
 )
 
+// Dashboard set to "true" on a namespace makes it a data science project in the dashboard, on a Secret
+// makes it a data connection.
+const Dashboard = "opendatahub.io/dashboard"
+
+// DataConnection set on a Secret of the applications namespace publishes it as a data connection which can
+// be copied to the DataScienceProjects: to all of them when the value is DataConnectionDefault, only to the
+// projects listing it otherwise.
+const (
+	DataConnection        = "platform.opendatahub.io/data-connection"
+	DataConnectionDefault = "default"
+)
+
+// DataScienceProject set on a namespace holds the name of the DataScienceProject it belongs to. It is set on the
+// namespaces created for a project, and must be set on a pre-existing namespace for a project to adopt it.
+const DataScienceProject = "platform.opendatahub.io/data-science-project"
+
 // K8SCommon keeps common kubernetes labels [1]
 // used across the project.
 // [1] (https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/#labels)
@@ -0,0 +1,376 @@
+// Package catalog publishes the endpoints exposed by the platform (dashboard, pipelines, model registry,
+// serving, ...) in a single service catalog, so that users have one place to discover the platform URLs.
+package catalog
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	ConfigMapName = "platform-service-catalog"
+	JSONKey       = "catalog.json"
+
+	// ServingComponent is the component reported for the endpoints of the InferenceServices.
+	ServingComponent = "serving"
+
+	DefaultInterval = 5 * time.Minute
+
+	// MaxServingEndpoints bounds the number of InferenceServices listed in the catalog, so that it fits in
+	// the ConfigMap.
+	MaxServingEndpoints = 1000
+	// servingPageSize is the number of InferenceServices listed at once from the API server.
+	servingPageSize = 500
+	// maxCatalogSize is the maximum size of the catalog, below the 1MiB limit of the ConfigMaps.
+	maxCatalogSize = 900 * 1024
+
+	// consoleLinkLabel marks the ConsoleLinks generated out of the catalog, so that the stale ones can be removed.
+	consoleLinkLabel = "platform.opendatahub.io/service-catalog"
+)
+
+type OptsFn func(*Exporter)
+
+func WithInterval(interval time.Duration) OptsFn {
+	return func(e *Exporter) {
+		e.interval = interval
+	}
+}
+
+// WithAPIReader sets the reader the endpoints are listed with, it should not be cached, as the Routes and
+// InferenceServices are not otherwise watched by the operator. Defaults to the client of the exporter.
+func WithAPIReader(r client.Reader) OptsFn {
+	return func(e *Exporter) {
+		e.reader = r
+	}
+}
+
+// WithConsoleLinks enables the publication of the platform endpoints as OpenShift console links, in the
+// application menu of the console.
+func WithConsoleLinks(enabled bool) OptsFn {
+	return func(e *Exporter) {
+		e.consoleLinks = enabled
+	}
+}
+
+// Endpoint is an URL exposed by the platform.
+type Endpoint struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Component string `json:"component"`
+	Kind      string `json:"kind"`
+	URL       string `json:"url"`
+}
+
+// Catalog is the document stored in the ConfigMap.
+type Catalog struct {
+	Endpoints []Endpoint `json:"endpoints"`
+	// Truncated is set when the data science projects have more than MaxServingEndpoints InferenceServices,
+	// only the first ones are listed.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Exporter periodically collects the Routes deployed by the platform and the URLs of the InferenceServices
+// and stores them in the ConfigMapName ConfigMap of the applications namespace, owned by the DSCInitialization.
+type Exporter struct {
+	client       client.Client
+	reader       client.Reader
+	interval     time.Duration
+	consoleLinks bool
+}
+
+func New(cli client.Client, opts ...OptsFn) *Exporter {
+	e := Exporter{
+		client:   cli,
+		interval: DefaultInterval,
+	}
+
+	for _, o := range opts {
+		o(&e)
+	}
+
+	if e.reader == nil {
+		e.reader = cli
+	}
+
+	return &e
+}
+
+func (e *Exporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (e *Exporter) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := e.Export(ctx); err != nil {
+			e.log(ctx).Error(err, "Failed to export platform service catalog")
+		}
+	}, e.interval)
+
+	return nil
+}
+
+// Export generates the catalog and stores it in the ConfigMap, and in console links if enabled. It is a
+// no-op if the platform has not been initialized yet.
+func (e *Exporter) Export(ctx context.Context) error {
+	dscis := dsciv1.DSCInitializationList{}
+	if err := e.client.List(ctx, &dscis); err != nil {
+		return fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	if len(dscis.Items) != 1 {
+		return nil
+	}
+
+	dsci := &dscis.Items[0]
+
+	c, err := e.Generate(ctx, dsci)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal platform service catalog: %w", err)
+	}
+
+	if len(asJSON) > maxCatalogSize {
+		return fmt.Errorf("platform service catalog of %d bytes exceeds the maximum size of %d bytes", len(asJSON), maxCatalogSize)
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: dsci.Spec.ApplicationsNamespace,
+		},
+		Data: map[string]string{
+			JSONKey: string(asJSON),
+		},
+	}
+
+	err = cluster.CreateOrUpdateConfigMap(
+		ctx,
+		e.client,
+		&cm,
+		cluster.OwnedBy(dsci, e.client.Scheme()),
+		cluster.WithLabels(labels.PlatformPartOf, labels.Platform),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store platform service catalog: %w", err)
+	}
+
+	return e.syncConsoleLinks(ctx, dsci, c)
+}
+
+// Generate collects the endpoints of the platform: the Routes labeled as part of the platform in the
+// applications namespace, as the label can be set by anyone on the Routes of the other namespaces, and the
+// InferenceServices of the data science projects having an URL, when KServe is installed.
+func (e *Exporter) Generate(ctx context.Context, dsci *dsciv1.DSCInitialization) (*Catalog, error) {
+	c := Catalog{Endpoints: make([]Endpoint, 0)}
+
+	routes := routev1.RouteList{}
+	err := e.reader.List(ctx, &routes,
+		client.InNamespace(dsci.Spec.ApplicationsNamespace),
+		client.HasLabels{labels.PlatformPartOf},
+	)
+	switch {
+	case meta.IsNoMatchError(err):
+		// not running on OpenShift
+	case err != nil:
+		return nil, fmt.Errorf("failed to list Routes: %w", err)
+	}
+
+	for i := range routes.Items {
+		r := &routes.Items[i]
+		c.Endpoints = append(c.Endpoints, Endpoint{
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Component: r.Labels[labels.PlatformPartOf],
+			Kind:      "Route",
+			URL:       routeURL(r),
+		})
+	}
+
+	serving, truncated, err := e.servingEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Endpoints = append(c.Endpoints, serving...)
+	c.Truncated = truncated
+
+	slices.SortFunc(c.Endpoints, func(a, b Endpoint) int {
+		return cmp.Or(
+			cmp.Compare(a.Component, b.Component),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return &c, nil
+}
+
+// servingEndpoints collects the URLs of the InferenceServices of the data science projects, i.e. the
+// namespaces labeled with labels.Dashboard. They are listed page by page, and at most MaxServingEndpoints
+// are returned, along with whether some were left out.
+func (e *Exporter) servingEndpoints(ctx context.Context) ([]Endpoint, bool, error) {
+	namespaces := metav1.PartialObjectMetadataList{}
+	namespaces.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+
+	if err := e.reader.List(ctx, &namespaces, client.MatchingLabels{labels.Dashboard: labels.True}); err != nil {
+		return nil, false, fmt.Errorf("failed to list data science projects: %w", err)
+	}
+
+	endpoints := make([]Endpoint, 0)
+
+	for _, ns := range namespaces.Items {
+		isvcs := unstructured.UnstructuredList{}
+		isvcs.SetGroupVersionKind(gvk.InferenceService)
+
+		for {
+			err := e.reader.List(ctx, &isvcs,
+				client.InNamespace(ns.Name),
+				client.Limit(servingPageSize),
+				client.Continue(isvcs.GetContinue()),
+			)
+			switch {
+			case meta.IsNoMatchError(err):
+				// KServe is not installed
+				return endpoints, false, nil
+			case err != nil:
+				return nil, false, fmt.Errorf("failed to list InferenceServices in namespace %s: %w", ns.Name, err)
+			}
+
+			for i := range isvcs.Items {
+				isvc := &isvcs.Items[i]
+
+				url, _, _ := unstructured.NestedString(isvc.Object, "status", "url")
+				if url == "" {
+					continue
+				}
+
+				if len(endpoints) == MaxServingEndpoints {
+					return endpoints, true, nil
+				}
+
+				endpoints = append(endpoints, Endpoint{
+					Name:      isvc.GetName(),
+					Namespace: isvc.GetNamespace(),
+					Component: ServingComponent,
+					Kind:      gvk.InferenceService.Kind,
+					URL:       url,
+				})
+			}
+
+			if isvcs.GetContinue() == "" {
+				break
+			}
+		}
+	}
+
+	return endpoints, false, nil
+}
+
+func routeURL(r *routev1.Route) string {
+	host := r.Spec.Host
+	if len(r.Status.Ingress) > 0 && r.Status.Ingress[0].Host != "" {
+		host = r.Status.Ingress[0].Host
+	}
+
+	scheme := "http"
+	if r.Spec.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + host + r.Spec.Path
+}
+
+// syncConsoleLinks creates a console link for each endpoint of the platform components, and removes the
+// ones of the endpoints which are gone. The endpoints of the InferenceServices are not linked, as they
+// are APIs and there can be many of them.
+func (e *Exporter) syncConsoleLinks(ctx context.Context, dsci *dsciv1.DSCInitialization, c *Catalog) error {
+	desired := map[string]Endpoint{}
+	if e.consoleLinks {
+		for _, ep := range c.Endpoints {
+			if ep.Component != ServingComponent {
+				desired[consoleLinkName(ep)] = ep
+			}
+		}
+	}
+
+	links := consolev1.ConsoleLinkList{}
+	err := e.client.List(ctx, &links, client.MatchingLabels{consoleLinkLabel: labels.True})
+	switch {
+	case meta.IsNoMatchError(err):
+		// not running on OpenShift
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to list ConsoleLinks: %w", err)
+	}
+
+	for i := range links.Items {
+		if _, ok := desired[links.Items[i].Name]; ok {
+			continue
+		}
+
+		if err := e.client.Delete(ctx, &links.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete ConsoleLink %s: %w", links.Items[i].Name, err)
+		}
+	}
+
+	section := string(cluster.GetRelease().Name)
+
+	for name, ep := range desired {
+		link := consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{Name: name}}
+
+		_, err := controllerutil.CreateOrPatch(ctx, e.client, &link, func() error {
+			link.Spec = consolev1.ConsoleLinkSpec{
+				Link: consolev1.Link{
+					Text: fmt.Sprintf("%s (%s)", ep.Name, ep.Component),
+					Href: ep.URL,
+				},
+				Location:        consolev1.ApplicationMenu,
+				ApplicationMenu: &consolev1.ApplicationMenuSpec{Section: section},
+			}
+
+			return cluster.ApplyMetaOptions(&link,
+				cluster.OwnedBy(dsci, e.client.Scheme()),
+				cluster.WithLabels(labels.PlatformPartOf, labels.Platform, consoleLinkLabel, labels.True),
+			)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store ConsoleLink %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func consoleLinkName(ep Endpoint) string {
+	return strings.ToLower(fmt.Sprintf("odh-catalog-%s-%s", ep.Namespace, ep.Name))
+}
+
+func (e *Exporter) log(ctx context.Context) logr.Logger {
+	return logf.FromContext(ctx).WithName("service").WithName("catalog")
+}
@@ -0,0 +1,184 @@
+package catalog_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/catalog"
+
+	. "github.com/onsi/gomega"
+)
+
+const appNamespace = "opendatahub"
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(routev1.Install(scheme))
+	utilruntime.Must(consolev1.Install(scheme))
+	utilruntime.Must(dsciv1.AddToScheme(scheme))
+
+	return scheme
+}
+
+func newDSCI() *dsciv1.DSCInitialization {
+	return &dsciv1.DSCInitialization{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dsciv1.GroupVersion.String(),
+			Kind:       gvk.DSCInitialization.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci", UID: "dsci-uid"},
+		Spec:       dsciv1.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+}
+
+func newRoute(name string, partOf string) *routev1.Route {
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: appNamespace},
+		Spec: routev1.RouteSpec{
+			Host: name + ".apps.example.com",
+			TLS:  &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge},
+		},
+	}
+
+	if partOf != "" {
+		route.Labels = map[string]string{labels.PlatformPartOf: partOf}
+	}
+
+	return route
+}
+
+// newUserRoute returns a Route labeled as part of the platform, in a namespace the users have access to.
+func newUserRoute(namespace string, name string, partOf string) *routev1.Route {
+	route := newRoute(name, partOf)
+	route.Namespace = namespace
+
+	return route
+}
+
+func newProject(name string, dashboard bool) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if dashboard {
+		ns.Labels = map[string]string{labels.Dashboard: labels.True}
+	}
+
+	return ns
+}
+
+func newInferenceService(namespace string, name string, url string) *unstructured.Unstructured {
+	isvc := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name, "namespace": namespace},
+		"status":   map[string]any{"url": url},
+	}}
+	isvc.SetGroupVersionKind(gvk.InferenceService)
+
+	return isvc
+}
+
+func newServingScheme() *runtime.Scheme {
+	scheme := newScheme()
+	scheme.AddKnownTypeWithName(gvk.InferenceService, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(gvk.InferenceService.GroupVersion().WithKind("InferenceServiceList"), &unstructured.UnstructuredList{})
+
+	return scheme
+}
+
+func TestGenerate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cli := clientFake.NewClientBuilder().WithScheme(newServingScheme()).WithObjects(
+		newRoute("rhods-dashboard", "dashboard"),
+		newRoute("user-route", ""),
+		newUserRoute("user-project", "spoofed-dashboard", "dashboard"),
+		newProject("user-project", true),
+		newProject("other", false),
+		newInferenceService("user-project", "granite", "https://granite-user-project.apps.example.com"),
+		newInferenceService("user-project", "not-ready", ""),
+		newInferenceService("other", "outside-projects", "https://outside-projects-other.apps.example.com"),
+	).Build()
+
+	c, err := catalog.New(cli).Generate(ctx, newDSCI())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(c.Truncated).Should(BeFalse())
+	g.Expect(c.Endpoints).Should(Equal([]catalog.Endpoint{
+		{
+			Name:      "rhods-dashboard",
+			Namespace: appNamespace,
+			Component: "dashboard",
+			Kind:      "Route",
+			URL:       "https://rhods-dashboard.apps.example.com",
+		},
+		{
+			Name:      "granite",
+			Namespace: "user-project",
+			Component: catalog.ServingComponent,
+			Kind:      gvk.InferenceService.Kind,
+			URL:       "https://granite-user-project.apps.example.com",
+		},
+	}))
+}
+
+func TestGenerateTruncated(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	objs := []client.Object{newProject("user-project", true)}
+	for i := range catalog.MaxServingEndpoints + 1 {
+		name := fmt.Sprintf("isvc-%04d", i)
+		objs = append(objs, newInferenceService("user-project", name, "https://"+name+".apps.example.com"))
+	}
+
+	cli := clientFake.NewClientBuilder().WithScheme(newServingScheme()).WithObjects(objs...).Build()
+
+	c, err := catalog.New(cli).Generate(ctx, newDSCI())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(c.Truncated).Should(BeTrue())
+	g.Expect(c.Endpoints).Should(HaveLen(catalog.MaxServingEndpoints))
+}
+
+func TestExport(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	stale := &consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{
+		Name:   "odh-catalog-opendatahub-removed",
+		Labels: map[string]string{"platform.opendatahub.io/service-catalog": "true"},
+	}}
+
+	cli := clientFake.NewClientBuilder().WithScheme(newScheme()).WithObjects(
+		newDSCI(),
+		newRoute("rhods-dashboard", "dashboard"),
+		stale,
+	).Build()
+
+	err := catalog.New(cli, catalog.WithConsoleLinks(true)).Export(ctx)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cm := corev1.ConfigMap{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: catalog.ConfigMapName}, &cm)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cm.OwnerReferences).Should(HaveLen(1))
+	g.Expect(cm.Data[catalog.JSONKey]).Should(ContainSubstring(`"url": "https://rhods-dashboard.apps.example.com"`))
+
+	links := consolev1.ConsoleLinkList{}
+	g.Expect(cli.List(ctx, &links)).Should(Succeed())
+	g.Expect(links.Items).Should(HaveLen(1))
+	g.Expect(links.Items[0].Name).Should(Equal("odh-catalog-opendatahub-rhods-dashboard"))
+	g.Expect(links.Items[0].Spec.Href).Should(Equal("https://rhods-dashboard.apps.example.com"))
+	g.Expect(links.Items[0].Spec.Location).Should(Equal(consolev1.ApplicationMenu))
+}
@@ -0,0 +1,296 @@
+// Package certs serves the certificate of the webhook server of the operator, issued either by the OpenShift
+// service CA or by cert-manager, and follows its rotation without restarting the operator.
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultCertDir is the directory the serving certificate secret is mounted to.
+	DefaultCertDir = "/tmp/k8s-webhook-server/serving-certs"
+	// DefaultInterval is how often the mounted certificate is checked for changes.
+	DefaultInterval = time.Minute
+	// DefaultWebhookServiceName is the name of the Service the webhook configurations of the operator point to.
+	DefaultWebhookServiceName = "opendatahub-operator-webhook-service"
+
+	CertName = "tls.crt"
+	KeyName  = "tls.key"
+	// CAName is the key of the issuing CA in the secrets created by cert-manager. The service CA does not add
+	// it, as it injects its bundle into the webhook configurations itself.
+	CAName = "ca.crt"
+)
+
+var ErrNotLoaded = errors.New("webhook serving certificate not loaded")
+
+type OptsFn func(*Service)
+
+// WithCertDir sets the directory the certificate, its key and optionally its CA are read from.
+func WithCertDir(dir string) OptsFn {
+	return func(s *Service) {
+		s.dir = dir
+	}
+}
+
+// WithInterval sets how often the certificate is checked for changes.
+func WithInterval(interval time.Duration) OptsFn {
+	return func(s *Service) {
+		s.interval = interval
+	}
+}
+
+// WithWebhookService sets the Service whose webhooks get the CA bundle injected when the certificate comes
+// with its CA. An empty namespace disables the injection.
+func WithWebhookService(namespace string, name string) OptsFn {
+	return func(s *Service) {
+		s.namespace = namespace
+		s.name = name
+	}
+}
+
+// Service reloads the serving certificate of the webhook server whenever the mounted secret is rotated, and keeps
+// the CA bundle of the webhook configurations in sync when nothing else does, i.e. with cert-manager. It is meant
+// to be added to the manager as a Runnable, and its TLSOpts passed to the webhook server.
+type Service struct {
+	client    client.Client
+	dir       string
+	interval  time.Duration
+	namespace string
+	name      string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	certPEM  []byte
+	keyPEM   []byte
+	caPEM    []byte
+	caSynced bool
+}
+
+func New(cli client.Client, opts ...OptsFn) *Service {
+	s := Service{
+		client:   cli,
+		dir:      DefaultCertDir,
+		interval: DefaultInterval,
+		name:     DefaultWebhookServiceName,
+	}
+
+	for _, o := range opts {
+		o(&s)
+	}
+
+	return &s
+}
+
+// NeedLeaderElection returns false, as every replica of the operator serves the webhooks.
+func (s *Service) NeedLeaderElection() bool {
+	return false
+}
+
+// TLSOpts makes the webhook server present the certificate loaded by the service, in place of the one of its
+// own watcher, which can miss the updates of secret volumes.
+func (s *Service) TLSOpts(c *tls.Config) {
+	c.GetCertificate = s.GetCertificate
+}
+
+// GetCertificate returns the current serving certificate, loading it if needed.
+func (s *Service) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	cert := s.cert
+	s.mu.RUnlock()
+
+	if cert != nil {
+		return cert, nil
+	}
+
+	if _, err := s.Load(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotLoaded, err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cert, nil
+}
+
+// Load reads the certificate from the directory and returns whether it changed since the last time.
+func (s *Service) Load() (bool, error) {
+	certPEM, err := os.ReadFile(filepath.Join(s.dir, CertName))
+	if err != nil {
+		return false, fmt.Errorf("failed to read certificate: %w", err)
+	}
+
+	keyPEM, err := os.ReadFile(filepath.Join(s.dir, KeyName))
+	if err != nil {
+		return false, fmt.Errorf("failed to read certificate key: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(filepath.Join(s.dir, CAName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("failed to read certificate authority: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cert != nil && bytes.Equal(certPEM, s.certPEM) && bytes.Equal(keyPEM, s.keyPEM) && bytes.Equal(caPEM, s.caPEM) {
+		return false, nil
+	}
+
+	// the certificate and its key are not swapped atomically by the kubelet, a mismatch is retried
+	// on the next check
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	s.cert = &cert
+	s.certPEM = certPEM
+	s.keyPEM = keyPEM
+	s.caSynced = s.caSynced && bytes.Equal(caPEM, s.caPEM)
+	s.caPEM = caPEM
+
+	return true, nil
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	l := logf.FromContext(ctx).WithName("webhook-certs")
+
+	if _, err := os.Stat(s.dir); errors.Is(err, os.ErrNotExist) {
+		l.Info("Webhook certificate directory not found, certificate rotation is not handled", "dir", s.dir)
+		return nil
+	}
+
+	l.Info("Watching webhook serving certificate", "dir", s.dir, "interval", s.interval)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		s.reload(ctx, l)
+	}, s.interval)
+
+	return nil
+}
+
+func (s *Service) reload(ctx context.Context, l logr.Logger) {
+	changed, err := s.Load()
+	if err != nil {
+		l.Error(err, "Failed to load webhook serving certificate")
+		return
+	}
+
+	if changed {
+		l.Info("Loaded webhook serving certificate", "notAfter", s.notAfter())
+	}
+
+	s.mu.RLock()
+	caPEM := s.caPEM
+	synced := s.caSynced
+	s.mu.RUnlock()
+
+	if len(caPEM) == 0 || synced || s.namespace == "" {
+		return
+	}
+
+	if err := s.injectCABundle(ctx, caPEM); err != nil {
+		l.Error(err, "Failed to inject the CA bundle in the webhook configurations")
+		return
+	}
+
+	s.mu.Lock()
+	s.caSynced = bytes.Equal(caPEM, s.caPEM)
+	s.mu.Unlock()
+}
+
+func (s *Service) notAfter() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cert == nil || len(s.cert.Certificate) == 0 {
+		return time.Time{}
+	}
+
+	leaf, err := x509.ParseCertificate(s.cert.Certificate[0])
+	if err != nil {
+		return time.Time{}
+	}
+
+	return leaf.NotAfter
+}
+
+// injectCABundle sets the CA bundle of the webhooks served by the webhook Service of the operator.
+func (s *Service) injectCABundle(ctx context.Context, caPEM []byte) error {
+	validating := admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := s.client.List(ctx, &validating); err != nil {
+		return fmt.Errorf("failed to list validating webhook configurations: %w", err)
+	}
+
+	for i := range validating.Items {
+		wc := &validating.Items[i]
+		base := wc.DeepCopy()
+
+		changed := false
+		for j := range wc.Webhooks {
+			changed = s.setCABundle(&wc.Webhooks[j].ClientConfig, caPEM) || changed
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := s.client.Patch(ctx, wc, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("failed to update validating webhook configuration %s: %w", wc.Name, err)
+		}
+	}
+
+	mutating := admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := s.client.List(ctx, &mutating); err != nil {
+		return fmt.Errorf("failed to list mutating webhook configurations: %w", err)
+	}
+
+	for i := range mutating.Items {
+		wc := &mutating.Items[i]
+		base := wc.DeepCopy()
+
+		changed := false
+		for j := range wc.Webhooks {
+			changed = s.setCABundle(&wc.Webhooks[j].ClientConfig, caPEM) || changed
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := s.client.Patch(ctx, wc, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("failed to update mutating webhook configuration %s: %w", wc.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) setCABundle(cc *admissionregistrationv1.WebhookClientConfig, caPEM []byte) bool {
+	if cc.Service == nil || cc.Service.Namespace != s.namespace || cc.Service.Name != s.name {
+		return false
+	}
+
+	if bytes.Equal(cc.CABundle, caPEM) {
+		return false
+	}
+
+	cc.CABundle = caPEM
+
+	return true
+}
@@ -0,0 +1,155 @@
+package certs_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/certs"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+// writeCert writes a self-signed certificate for the given common name to the directory, and returns its PEM.
+func writeCert(t *testing.T, dir string, cn string) []byte {
+	t.Helper()
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	g.Expect(os.WriteFile(filepath.Join(dir, certs.CertName), certPEM, 0o600)).Should(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, certs.KeyName), keyPEM, 0o600)).Should(Succeed())
+
+	return certPEM
+}
+
+func commonName(t *testing.T, s *certs.Service) string {
+	t.Helper()
+	g := NewWithT(t)
+
+	cert, err := s.GetCertificate(nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return leaf.Subject.CommonName
+}
+
+func TestLoad(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+
+	s := certs.New(nil, certs.WithCertDir(dir))
+
+	_, err := s.GetCertificate(nil)
+	g.Expect(err).Should(MatchError(certs.ErrNotLoaded))
+
+	writeCert(t, dir, "first")
+	g.Expect(commonName(t, s)).Should(Equal("first"))
+
+	changed, err := s.Load()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(changed).Should(BeFalse())
+
+	writeCert(t, dir, "rotated")
+
+	changed, err = s.Load()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(changed).Should(BeTrue())
+	g.Expect(commonName(t, s)).Should(Equal("rotated"))
+}
+
+func TestInjectCABundle(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+
+	writeCert(t, dir, "serving")
+	caPEM := writeCert(t, t.TempDir(), "ca")
+	g.Expect(os.WriteFile(filepath.Join(dir, certs.CAName), caPEM, 0o600)).Should(Succeed())
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(admissionregistrationv1.AddToScheme(scheme))
+
+	clientConfig := func(namespace string) admissionregistrationv1.WebhookClientConfig {
+		return admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: namespace,
+				Name:      certs.DefaultWebhookServiceName,
+			},
+		}
+	}
+
+	cli := clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "operator.opendatahub.io", ClientConfig: clientConfig(operatorNamespace)},
+			},
+		},
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{
+				{Name: "other.example.com", ClientConfig: clientConfig("other")},
+			},
+		},
+	).Build()
+
+	s := certs.New(cli,
+		certs.WithCertDir(dir),
+		certs.WithInterval(10*time.Millisecond),
+		certs.WithWebhookService(operatorNamespace, certs.DefaultWebhookServiceName),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = s.Start(ctx)
+	}()
+
+	g.Eventually(func(g Gomega) {
+		vwc := admissionregistrationv1.ValidatingWebhookConfiguration{}
+		g.Expect(cli.Get(ctx, client.ObjectKey{Name: "operator"}, &vwc)).Should(Succeed())
+		g.Expect(vwc.Webhooks[0].ClientConfig.CABundle).Should(Equal(caPEM))
+	}).Should(Succeed())
+
+	mwc := admissionregistrationv1.MutatingWebhookConfiguration{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: "other"}, &mwc)).Should(Succeed())
+	g.Expect(mwc.Webhooks[0].ClientConfig.CABundle).Should(BeEmpty())
+}
@@ -0,0 +1,276 @@
+// Package health provides a lightweight HTTP endpoint exposing overall platform health,
+// so that external probes, load balancers and status pages can consume it without
+// access to the Kubernetes API.
+package health
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+const (
+	// Path is the path the platform health report is served on.
+	Path = "/platform/status"
+
+	// DisabledBindAddress can be used as bind address to disable the endpoint.
+	DisabledBindAddress = "0"
+
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+type State string
+
+const (
+	StateOK       State = "OK"
+	StateDegraded State = "Degraded"
+)
+
+// ComponentHealth summarizes the health of a single component. It intentionally carries
+// only the reason of the underlying condition, as the endpoint might be exposed without
+// authentication and messages can contain details about the cluster.
+type ComponentHealth struct {
+	Status State  `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Report is the document returned by the endpoint.
+type Report struct {
+	Status     State                      `json:"status"`
+	Reason     string                     `json:"reason,omitempty"`
+	Components map[string]ComponentHealth `json:"components,omitempty"`
+}
+
+type OptsFn func(*Service)
+
+// WithBindAddress sets the address the endpoint listens on. Using DisabledBindAddress
+// or an empty value disables the endpoint.
+func WithBindAddress(addr string) OptsFn {
+	return func(s *Service) {
+		s.addr = addr
+	}
+}
+
+// WithBearerToken requires requests to present the given token in the Authorization header.
+// If the token is empty, the endpoint is served without authentication.
+func WithBearerToken(token string) OptsFn {
+	return func(s *Service) {
+		s.token = strings.TrimSpace(token)
+	}
+}
+
+// Service serves the platform health report, it is meant to be added to the manager
+// as a Runnable.
+type Service struct {
+	client client.Reader
+	addr   string
+	token  string
+}
+
+func New(cli client.Reader, opts ...OptsFn) *Service {
+	s := Service{
+		client: cli,
+		addr:   DisabledBindAddress,
+	}
+
+	for _, o := range opts {
+		o(&s)
+	}
+
+	return &s
+}
+
+// NeedLeaderElection returns false, so the endpoint is served by every replica of the operator.
+func (s *Service) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	l := s.log(ctx)
+
+	if s.addr == "" || s.addr == DisabledBindAddress {
+		l.Info("Platform status endpoint is disabled")
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, s)
+
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext: func(_ net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		sctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(sctx); err != nil {
+			l.Error(err, "Failed to shutdown platform status endpoint")
+		}
+	}()
+
+	l.Info("Starting platform status endpoint", "address", s.addr, "path", Path, "authenticated", s.token != "")
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("cannot serve platform status endpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	report, err := s.Report(r.Context())
+	if err != nil {
+		s.log(r.Context()).Error(err, "Failed to compute platform status")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	code := http.StatusOK
+	if report.Status != StateOK {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		s.log(r.Context()).Error(err, "Failed to write platform status")
+	}
+}
+
+// Report computes the platform health out of the DSCInitialization and DataScienceCluster status.
+func (s *Service) Report(ctx context.Context) (*Report, error) {
+	dscis := dsciv1.DSCInitializationList{}
+	if err := s.client.List(ctx, &dscis); err != nil {
+		return nil, fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	dscs := dscv1.DataScienceClusterList{}
+	if err := s.client.List(ctx, &dscs); err != nil {
+		return nil, fmt.Errorf("failed to list DataScienceCluster: %w", err)
+	}
+
+	switch {
+	case len(dscis.Items) != 1:
+		return &Report{Status: StateDegraded, Reason: "DSCInitializationNotFound"}, nil
+	case len(dscs.Items) != 1:
+		return &Report{Status: StateDegraded, Reason: "DataScienceClusterNotFound"}, nil
+	}
+
+	report := Report{
+		Status:     StateOK,
+		Components: componentsHealth(&dscs.Items[0]),
+	}
+
+	if dscis.Items[0].Status.Phase != status.PhaseReady {
+		report.Status = StateDegraded
+		report.Reason = "DSCInitializationNotReady"
+	}
+
+	if report.Status == StateOK {
+		for _, c := range report.Components {
+			if c.Status != StateOK {
+				report.Status = StateDegraded
+				report.Reason = "ComponentsNotReady"
+
+				break
+			}
+		}
+	}
+
+	return &report, nil
+}
+
+// componentsHealth derives per-component health from the <Kind>Ready conditions the DataScienceCluster
+// reconciler maintains for each component, components which are not managed are skipped.
+func componentsHealth(dsc *dscv1.DataScienceCluster) map[string]ComponentHealth {
+	result := make(map[string]ComponentHealth)
+
+	for _, c := range dsc.Status.Conditions {
+		ct := string(c.Type)
+		if ct == status.ConditionTypeReady || !strings.HasSuffix(ct, status.ReadySuffix) {
+			continue
+		}
+
+		// the DataScienceCluster reconciler reports removed components with a dedicated reason
+		if c.Reason == string(operatorv1.Removed) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(ct, status.ReadySuffix))
+		result[name] = ComponentHealth{
+			Status: stateOf(c),
+			Reason: c.Reason,
+		}
+	}
+
+	return result
+}
+
+func stateOf(c conditionsv1.Condition) State {
+	if c.Status == corev1.ConditionTrue {
+		return StateOK
+	}
+
+	return StateDegraded
+}
+
+func (s *Service) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func (s *Service) log(ctx context.Context) logr.Logger {
+	return logf.FromContext(ctx).WithName("service").WithName("health")
+}
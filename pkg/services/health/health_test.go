@@ -0,0 +1,179 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/health"
+
+	. "github.com/onsi/gomega"
+)
+
+func newClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(dsciv1.AddToScheme(scheme))
+	utilruntime.Must(dscv1.AddToScheme(scheme))
+
+	return clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newDSCI(phase string) *dsciv1.DSCInitialization {
+	return &dsciv1.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Status:     dsciv1.DSCInitializationStatus{Phase: phase},
+	}
+}
+
+func newDSC(conditions ...conditionsv1.Condition) *dscv1.DataScienceCluster {
+	return &dscv1.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+		Status:     dscv1.DataScienceClusterStatus{Conditions: conditions},
+	}
+}
+
+func readyCondition(kind string, s corev1.ConditionStatus, reason string) conditionsv1.Condition {
+	return conditionsv1.Condition{
+		Type:    conditionsv1.ConditionType(kind + status.ReadySuffix),
+		Status:  s,
+		Reason:  reason,
+		Message: "details which should not be exposed",
+	}
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		status     health.State
+		reason     string
+		components map[string]health.ComponentHealth
+	}{
+		{
+			name:   "Should be degraded when no DSCInitialization exists",
+			objs:   []client.Object{newDSC()},
+			status: health.StateDegraded,
+			reason: "DSCInitializationNotFound",
+		},
+		{
+			name:   "Should be degraded when no DataScienceCluster exists",
+			objs:   []client.Object{newDSCI(status.PhaseReady)},
+			status: health.StateDegraded,
+			reason: "DataScienceClusterNotFound",
+		},
+		{
+			name: "Should be ok when all components are ready",
+			objs: []client.Object{
+				newDSCI(status.PhaseReady),
+				newDSC(
+					readyCondition("Dashboard", corev1.ConditionTrue, status.ReconcileCompleted),
+					readyCondition("Ray", corev1.ConditionFalse, status.RemovedReason),
+				),
+			},
+			status: health.StateOK,
+			components: map[string]health.ComponentHealth{
+				"dashboard": {Status: health.StateOK, Reason: status.ReconcileCompleted},
+			},
+		},
+		{
+			name: "Should be degraded when a component is not ready",
+			objs: []client.Object{
+				newDSCI(status.PhaseReady),
+				newDSC(
+					readyCondition("Dashboard", corev1.ConditionTrue, status.ReconcileCompleted),
+					readyCondition("Kserve", corev1.ConditionFalse, status.ReconcileFailed),
+				),
+			},
+			status: health.StateDegraded,
+			reason: "ComponentsNotReady",
+			components: map[string]health.ComponentHealth{
+				"dashboard": {Status: health.StateOK, Reason: status.ReconcileCompleted},
+				"kserve":    {Status: health.StateDegraded, Reason: status.ReconcileFailed},
+			},
+		},
+		{
+			name: "Should be degraded when DSCInitialization is not ready",
+			objs: []client.Object{
+				newDSCI(status.PhaseProgressing),
+				newDSC(),
+			},
+			status:     health.StateDegraded,
+			reason:     "DSCInitializationNotReady",
+			components: map[string]health.ComponentHealth{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			report, err := health.New(newClient(tt.objs...)).Report(context.Background())
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(report.Status).Should(Equal(tt.status))
+			g.Expect(report.Reason).Should(Equal(tt.reason))
+
+			if tt.components != nil {
+				g.Expect(report.Components).Should(Equal(tt.components))
+			}
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	cli := newClient(
+		newDSCI(status.PhaseReady),
+		newDSC(readyCondition("Dashboard", corev1.ConditionTrue, status.ReconcileCompleted)),
+	)
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		auth   string
+		code   int
+	}{
+		{name: "Should serve report without token", method: http.MethodGet, code: http.StatusOK},
+		{name: "Should serve report with a valid token", method: http.MethodGet, token: "secret", auth: "Bearer secret", code: http.StatusOK},
+		{name: "Should reject a missing token", method: http.MethodGet, token: "secret", code: http.StatusUnauthorized},
+		{name: "Should reject an invalid token", method: http.MethodGet, token: "secret", auth: "Bearer wrong", code: http.StatusUnauthorized},
+		{name: "Should reject unsupported methods", method: http.MethodPost, code: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			req := httptest.NewRequest(tt.method, health.Path, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+
+			rec := httptest.NewRecorder()
+			health.New(cli, health.WithBearerToken(tt.token)).ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).Should(Equal(tt.code))
+
+			if tt.code != http.StatusOK {
+				return
+			}
+
+			report := health.Report{}
+			g.Expect(json.Unmarshal(rec.Body.Bytes(), &report)).Should(Succeed())
+			g.Expect(report.Status).Should(Equal(health.StateOK))
+			g.Expect(rec.Body.String()).ShouldNot(ContainSubstring("details which should not be exposed"))
+		})
+	}
+}
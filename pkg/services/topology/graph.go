@@ -0,0 +1,179 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type NodeKind string
+
+const (
+	// NodeKindWorkload represents the pods selected in a namespace.
+	NodeKindWorkload NodeKind = "Workload"
+	// NodeKindNamespace represents all the pods of the selected namespaces.
+	NodeKindNamespace NodeKind = "Namespace"
+	// NodeKindPeer represents a source which is not a workload, e.g. an IP block or a mesh principal.
+	NodeKindPeer NodeKind = "Peer"
+)
+
+type PolicyType string
+
+const (
+	PolicyTypeNetwork       PolicyType = "NetworkPolicy"
+	PolicyTypeAuthorization PolicyType = "AuthorizationPolicy"
+)
+
+const (
+	ActionAllow  = "ALLOW"
+	ActionDeny   = "DENY"
+	ActionCustom = "CUSTOM"
+	ActionAudit  = "AUDIT"
+)
+
+type Node struct {
+	ID        string   `json:"id"`
+	Kind      NodeKind `json:"kind"`
+	Namespace string   `json:"namespace,omitempty"`
+	Selector  string   `json:"selector,omitempty"`
+}
+
+// Edge represents traffic from a node to another one, as allowed (or denied) by a single policy.
+type Edge struct {
+	From   string     `json:"from"`
+	To     string     `json:"to"`
+	Type   PolicyType `json:"type"`
+	Policy string     `json:"policy"`
+	Action string     `json:"action"`
+	// Provider is the external authorizer in charge of CUSTOM authorization policies.
+	Provider string   `json:"provider,omitempty"`
+	Ports    []string `json:"ports,omitempty"`
+	Paths    []string `json:"paths,omitempty"`
+}
+
+// Graph is the effective network and authorization topology of the platform.
+type Graph struct {
+	// Capabilities holds the status of the platform capabilities the graph has been generated from.
+	Capabilities map[string]string `json:"capabilities,omitempty"`
+	Nodes        []Node            `json:"nodes"`
+	Edges        []Edge            `json:"edges"`
+}
+
+func NewGraph() *Graph {
+	return &Graph{
+		Capabilities: map[string]string{},
+		Nodes:        make([]Node, 0),
+		Edges:        make([]Edge, 0),
+	}
+}
+
+// AddNode adds the node to the graph, unless a node with the same ID already exists, and returns its ID.
+func (g *Graph) AddNode(n Node) string {
+	if !slices.ContainsFunc(g.Nodes, func(e Node) bool { return e.ID == n.ID }) {
+		g.Nodes = append(g.Nodes, n)
+	}
+
+	return n.ID
+}
+
+func (g *Graph) AddEdge(e Edge) {
+	g.Edges = append(g.Edges, e)
+}
+
+// Sort orders nodes and edges so that the rendered output is stable across runs.
+func (g *Graph) Sort() {
+	slices.SortFunc(g.Nodes, func(a, b Node) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	slices.SortFunc(g.Edges, func(a, b Edge) int {
+		if c := strings.Compare(a.From, b.From); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.To, b.To); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Policy, b.Policy)
+	})
+}
+
+func (g *Graph) JSON() (string, error) {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal topology: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// DOT renders the graph in the Graphviz DOT language. Network policies are rendered as solid edges,
+// authorization policies as dashed ones and denied traffic in red.
+func (g *Graph) DOT() string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph platform {\n")
+	sb.WriteString("  rankdir=LR;\n")
+
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&sb, "  %q [label=%q, shape=%s];\n", n.ID, n.label(), n.shape())
+	}
+
+	for _, e := range g.Edges {
+		attrs := []string{fmt.Sprintf("label=%q", e.label())}
+		if e.Type == PolicyTypeAuthorization {
+			attrs = append(attrs, "style=dashed")
+		}
+		if e.Action == ActionDeny {
+			attrs = append(attrs, "color=red")
+		}
+
+		fmt.Fprintf(&sb, "  %q -> %q [%s];\n", e.From, e.To, strings.Join(attrs, ", "))
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func (n Node) label() string {
+	switch {
+	case n.Namespace != "" && n.Selector != "":
+		return n.Namespace + "\n" + n.Selector
+	case n.Namespace != "":
+		return n.Namespace
+	case n.Selector != "":
+		return n.Selector
+	default:
+		return n.ID
+	}
+}
+
+func (n Node) shape() string {
+	switch n.Kind {
+	case NodeKindWorkload:
+		return "box"
+	case NodeKindNamespace:
+		return "folder"
+	default:
+		return "ellipse"
+	}
+}
+
+func (e Edge) label() string {
+	parts := []string{e.Policy}
+
+	action := e.Action
+	if e.Provider != "" {
+		action += "(" + e.Provider + ")"
+	}
+	parts = append(parts, action)
+
+	if len(e.Ports) > 0 {
+		parts = append(parts, strings.Join(e.Ports, ","))
+	}
+	if len(e.Paths) > 0 {
+		parts = append(parts, strings.Join(e.Paths, ","))
+	}
+
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,211 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	anySelector = "*"
+	anySourceID = "any"
+)
+
+// AddNetworkPolicy adds to the graph the traffic allowed by a NetworkPolicy, ingress rules are rendered as
+// edges from the peers to the selected pods and egress rules as edges from the selected pods to the peers.
+func (g *Graph) AddNetworkPolicy(np *networkingv1.NetworkPolicy) {
+	policy := np.Namespace + "/" + np.Name
+	target := g.AddNode(workloadNode(np.Namespace, &np.Spec.PodSelector))
+
+	for _, rule := range np.Spec.Ingress {
+		ports := networkPolicyPorts(rule.Ports)
+
+		for _, from := range g.networkPolicyPeers(np.Namespace, rule.From) {
+			g.AddEdge(Edge{From: from, To: target, Type: PolicyTypeNetwork, Policy: policy, Action: ActionAllow, Ports: ports})
+		}
+	}
+
+	for _, rule := range np.Spec.Egress {
+		ports := networkPolicyPorts(rule.Ports)
+
+		for _, to := range g.networkPolicyPeers(np.Namespace, rule.To) {
+			g.AddEdge(Edge{From: target, To: to, Type: PolicyTypeNetwork, Policy: policy, Action: ActionAllow, Ports: ports})
+		}
+	}
+}
+
+// AddAuthorizationPolicy adds to the graph the traffic matched by an Istio AuthorizationPolicy, as edges
+// from the sources of each rule to the selected workloads. A policy in the mesh root namespace applies to
+// the whole mesh.
+func (g *Graph) AddAuthorizationPolicy(ap *unstructured.Unstructured, rootNamespace string) {
+	policy := ap.GetNamespace() + "/" + ap.GetName()
+
+	action, _, _ := unstructured.NestedString(ap.Object, "spec", "action")
+	if action == "" {
+		action = ActionAllow
+	}
+
+	provider, _, _ := unstructured.NestedString(ap.Object, "spec", "provider", "name")
+	matchLabels, _, _ := unstructured.NestedStringMap(ap.Object, "spec", "selector", "matchLabels")
+
+	ns := ap.GetNamespace()
+	if ns == rootNamespace {
+		ns = ""
+	}
+
+	target := g.AddNode(workloadNode(ns, &metav1.LabelSelector{MatchLabels: matchLabels}))
+
+	rules, _, _ := unstructured.NestedSlice(ap.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		ports, paths := authorizationPolicyOperations(rule)
+
+		for _, from := range g.authorizationPolicySources(rule) {
+			g.AddEdge(Edge{
+				From:     from,
+				To:       target,
+				Type:     PolicyTypeAuthorization,
+				Policy:   policy,
+				Action:   action,
+				Provider: provider,
+				Ports:    ports,
+				Paths:    paths,
+			})
+		}
+	}
+}
+
+func (g *Graph) networkPolicyPeers(namespace string, peers []networkingv1.NetworkPolicyPeer) []string {
+	if len(peers) == 0 {
+		return []string{g.AddNode(Node{ID: anySourceID, Kind: NodeKindPeer})}
+	}
+
+	result := make([]string, 0, len(peers))
+	for _, p := range peers {
+		switch {
+		case p.IPBlock != nil:
+			id := "cidr:" + p.IPBlock.CIDR
+			if len(p.IPBlock.Except) > 0 {
+				id += " except " + strings.Join(p.IPBlock.Except, ",")
+			}
+			result = append(result, g.AddNode(Node{ID: id, Kind: NodeKindPeer}))
+		case p.NamespaceSelector != nil && p.PodSelector != nil:
+			sel := selectorString(p.NamespaceSelector) + "/" + selectorString(p.PodSelector)
+			result = append(result, g.AddNode(Node{ID: "pods:" + sel, Kind: NodeKindWorkload, Selector: sel}))
+		case p.NamespaceSelector != nil:
+			sel := selectorString(p.NamespaceSelector)
+			result = append(result, g.AddNode(Node{ID: "namespaces:" + sel, Kind: NodeKindNamespace, Selector: sel}))
+		default:
+			result = append(result, g.AddNode(workloadNode(namespace, p.PodSelector)))
+		}
+	}
+
+	return result
+}
+
+func (g *Graph) authorizationPolicySources(rule map[string]any) []string {
+	from, _, _ := unstructured.NestedSlice(rule, "from")
+	if len(from) == 0 {
+		return []string{g.AddNode(Node{ID: anySourceID, Kind: NodeKindPeer})}
+	}
+
+	result := make([]string, 0)
+	for _, f := range from {
+		source, ok := f.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, field := range []string{"principals", "requestPrincipals", "ipBlocks", "remoteIpBlocks"} {
+			values, _, _ := unstructured.NestedStringSlice(source, "source", field)
+			for _, v := range values {
+				result = append(result, g.AddNode(Node{ID: field + ":" + v, Kind: NodeKindPeer}))
+			}
+		}
+
+		namespaces, _, _ := unstructured.NestedStringSlice(source, "source", "namespaces")
+		for _, ns := range namespaces {
+			result = append(result, g.AddNode(Node{ID: "namespace:" + ns, Kind: NodeKindNamespace, Namespace: ns}))
+		}
+	}
+
+	if len(result) == 0 {
+		// only negative matches (e.g. notPrincipals) are set, which match any other source
+		result = append(result, g.AddNode(Node{ID: anySourceID, Kind: NodeKindPeer}))
+	}
+
+	return result
+}
+
+func authorizationPolicyOperations(rule map[string]any) ([]string, []string) {
+	var ports, paths []string
+
+	to, _, _ := unstructured.NestedSlice(rule, "to")
+	for _, t := range to {
+		operation, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		p, _, _ := unstructured.NestedStringSlice(operation, "operation", "ports")
+		ports = append(ports, p...)
+
+		p, _, _ = unstructured.NestedStringSlice(operation, "operation", "paths")
+		paths = append(paths, p...)
+	}
+
+	return ports, paths
+}
+
+func networkPolicyPorts(ports []networkingv1.NetworkPolicyPort) []string {
+	result := make([]string, 0, len(ports))
+	for _, p := range ports {
+		protocol := "TCP"
+		if p.Protocol != nil {
+			protocol = string(*p.Protocol)
+		}
+
+		port := anySelector
+		if p.Port != nil {
+			port = p.Port.String()
+		}
+		if p.EndPort != nil {
+			port = fmt.Sprintf("%s-%d", port, *p.EndPort)
+		}
+
+		result = append(result, protocol+"/"+port)
+	}
+
+	return result
+}
+
+func workloadNode(namespace string, selector *metav1.LabelSelector) Node {
+	sel := selectorString(selector)
+
+	ns := namespace
+	if ns == "" {
+		ns = anySelector
+	}
+
+	return Node{
+		ID:        "workload:" + ns + "/" + sel,
+		Kind:      NodeKindWorkload,
+		Namespace: namespace,
+		Selector:  sel,
+	}
+}
+
+func selectorString(selector *metav1.LabelSelector) string {
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return anySelector
+	}
+
+	return metav1.FormatLabelSelector(selector)
+}
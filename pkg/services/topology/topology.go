@@ -0,0 +1,221 @@
+// Package topology exports the effective network and authorization topology of the platform, i.e. who can
+// reach what, as a graph stored in a ConfigMap so that it can be consumed by visualization tools.
+package topology
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	ConfigMapName = "platform-topology"
+	JSONKey       = "topology.json"
+	DOTKey        = "topology.dot"
+
+	DefaultInterval = 5 * time.Minute
+)
+
+type OptsFn func(*Exporter)
+
+func WithInterval(interval time.Duration) OptsFn {
+	return func(e *Exporter) {
+		e.interval = interval
+	}
+}
+
+// WithAPIReader sets the reader the policies are listed with, it should not be cached, as the policies of
+// the platform namespaces are not otherwise watched by the operator. Defaults to the client of the exporter.
+func WithAPIReader(r client.Reader) OptsFn {
+	return func(e *Exporter) {
+		e.reader = r
+	}
+}
+
+// Exporter periodically regenerates the platform topology out of the NetworkPolicies and, when the
+// service mesh capability is available, the AuthorizationPolicies of the platform namespaces and stores
+// it in the ConfigMapName ConfigMap of the applications namespace, owned by the DSCInitialization.
+type Exporter struct {
+	client   client.Client
+	reader   client.Reader
+	interval time.Duration
+}
+
+func New(cli client.Client, opts ...OptsFn) *Exporter {
+	e := Exporter{
+		client:   cli,
+		interval: DefaultInterval,
+	}
+
+	for _, o := range opts {
+		o(&e)
+	}
+
+	if e.reader == nil {
+		e.reader = cli
+	}
+
+	return &e
+}
+
+func (e *Exporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (e *Exporter) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := e.Export(ctx); err != nil {
+			e.log(ctx).Error(err, "Failed to export platform topology")
+		}
+	}, e.interval)
+
+	return nil
+}
+
+// Export generates the topology and stores it in the ConfigMap, it is a no-op if the platform has not
+// been initialized yet.
+func (e *Exporter) Export(ctx context.Context) error {
+	dscis := dsciv1.DSCInitializationList{}
+	if err := e.client.List(ctx, &dscis); err != nil {
+		return fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	if len(dscis.Items) != 1 {
+		return nil
+	}
+
+	dsci := &dscis.Items[0]
+
+	g, err := e.Generate(ctx, dsci)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := g.JSON()
+	if err != nil {
+		return err
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: dsci.Spec.ApplicationsNamespace,
+		},
+		Data: map[string]string{
+			JSONKey: asJSON,
+			DOTKey:  g.DOT(),
+		},
+	}
+
+	err = cluster.CreateOrUpdateConfigMap(
+		ctx,
+		e.client,
+		&cm,
+		cluster.OwnedBy(dsci, e.client.Scheme()),
+		cluster.WithLabels(labels.PlatformPartOf, labels.Platform),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store platform topology: %w", err)
+	}
+
+	return nil
+}
+
+// Generate builds the topology graph for the namespaces of the platform.
+func (e *Exporter) Generate(ctx context.Context, dsci *dsciv1.DSCInitialization) (*Graph, error) {
+	g := NewGraph()
+
+	meshAvailable := capability(g, dsci, status.CapabilityServiceMesh)
+	capability(g, dsci, status.CapabilityServiceMeshAuthorization)
+
+	namespaces := []string{dsci.Spec.ApplicationsNamespace}
+	if ns := dsci.Spec.Monitoring.Namespace; ns != "" && ns != dsci.Spec.ApplicationsNamespace {
+		namespaces = append(namespaces, ns)
+	}
+
+	rootNamespace := ""
+	if meshAvailable && dsci.Spec.ServiceMesh != nil {
+		rootNamespace = dsci.Spec.ServiceMesh.ControlPlane.Namespace
+
+		authNamespace := strings.TrimSpace(dsci.Spec.ServiceMesh.Auth.Namespace)
+		if authNamespace == "" {
+			authNamespace = dsci.Spec.ApplicationsNamespace + "-auth-provider"
+		}
+
+		namespaces = append(namespaces, rootNamespace, authNamespace)
+	}
+
+	for _, ns := range namespaces {
+		if ns == "" {
+			continue
+		}
+
+		nps := networkingv1.NetworkPolicyList{}
+		if err := e.reader.List(ctx, &nps, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("failed to list NetworkPolicies in namespace %s: %w", ns, err)
+		}
+
+		for i := range nps.Items {
+			g.AddNetworkPolicy(&nps.Items[i])
+		}
+
+		if !meshAvailable {
+			continue
+		}
+
+		aps := unstructured.UnstructuredList{}
+		aps.SetGroupVersionKind(gvk.AuthorizationPolicy)
+
+		err := e.reader.List(ctx, &aps, client.InNamespace(ns))
+		switch {
+		case meta.IsNoMatchError(err):
+			// the capability is reported as available but the mesh CRDs are gone
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to list AuthorizationPolicies in namespace %s: %w", ns, err)
+		}
+
+		for i := range aps.Items {
+			g.AddAuthorizationPolicy(&aps.Items[i], rootNamespace)
+		}
+	}
+
+	g.Sort()
+
+	return g, nil
+}
+
+// capability records the status of the given capability condition in the graph and returns whether it is available.
+func capability(g *Graph, dsci *dsciv1.DSCInitialization, t conditionsv1.ConditionType) bool {
+	c := conditionsv1.FindStatusCondition(dsci.Status.Conditions, t)
+	if c == nil {
+		g.Capabilities[string(t)] = string(corev1.ConditionUnknown)
+		return false
+	}
+
+	g.Capabilities[string(t)] = string(c.Status)
+
+	return c.Status == corev1.ConditionTrue
+}
+
+func (e *Exporter) log(ctx context.Context) logr.Logger {
+	return logf.FromContext(ctx).WithName("service").WithName("topology")
+}
@@ -0,0 +1,140 @@
+package topology_test
+
+import (
+	"context"
+	"testing"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/services/topology"
+
+	. "github.com/onsi/gomega"
+)
+
+const appNamespace = "opendatahub"
+
+func newDSCI() *dsciv1.DSCInitialization {
+	return &dsciv1.DSCInitialization{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dsciv1.GroupVersion.String(),
+			Kind:       gvk.DSCInitialization.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci", UID: "dsci-uid"},
+		Spec:       dsciv1.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+		Status: dsciv1.DSCInitializationStatus{
+			Conditions: []conditionsv1.Condition{{
+				Type:   status.CapabilityServiceMesh,
+				Status: corev1.ConditionFalse,
+			}},
+		},
+	}
+}
+
+func newNetworkPolicy() *networkingv1.NetworkPolicy {
+	port := intstr.FromInt32(8443)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: appNamespace},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "dashboard"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &port}},
+				From: []networkingv1.NetworkPolicyPeer{{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"network.openshift.io/policy-group": "ingress"},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(networkingv1.AddToScheme(scheme))
+	utilruntime.Must(dsciv1.AddToScheme(scheme))
+
+	cli := clientFake.NewClientBuilder().WithScheme(scheme).WithObjects(newDSCI(), newNetworkPolicy()).Build()
+
+	err := topology.New(cli).Export(ctx)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cm := corev1.ConfigMap{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: topology.ConfigMapName}, &cm)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cm.OwnerReferences).Should(HaveLen(1))
+	g.Expect(cm.Data).Should(HaveKey(topology.JSONKey))
+	g.Expect(cm.Data[topology.DOTKey]).Should(And(
+		HavePrefix("digraph platform {"),
+		ContainSubstring(`"namespaces:network.openshift.io/policy-group=ingress" -> "workload:opendatahub/app=dashboard"`),
+		ContainSubstring(`TCP/8443`),
+	))
+	g.Expect(cm.Data[topology.JSONKey]).Should(ContainSubstring(`"CapabilityServiceMesh": "False"`))
+}
+
+func TestAddAuthorizationPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	ap := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "kserve-predictor", "namespace": "istio-system"},
+		"spec": map[string]any{
+			"action":   "CUSTOM",
+			"provider": map[string]any{"name": "opendatahub-auth-provider"},
+			"selector": map[string]any{"matchLabels": map[string]any{"component": "predictor"}},
+			"rules": []any{
+				map[string]any{
+					"to": []any{map[string]any{"operation": map[string]any{"paths": []any{"/v1/*"}}}},
+				},
+				map[string]any{
+					"from": []any{map[string]any{"source": map[string]any{"namespaces": []any{"user-ns"}}}},
+				},
+			},
+		},
+	}}
+	ap.SetGroupVersionKind(gvk.AuthorizationPolicy)
+
+	graph := topology.NewGraph()
+	graph.AddAuthorizationPolicy(&ap, "istio-system")
+	graph.Sort()
+
+	g.Expect(graph.Nodes).Should(ConsistOf(
+		topology.Node{ID: "any", Kind: topology.NodeKindPeer},
+		topology.Node{ID: "namespace:user-ns", Kind: topology.NodeKindNamespace, Namespace: "user-ns"},
+		topology.Node{ID: "workload:*/component=predictor", Kind: topology.NodeKindWorkload, Selector: "component=predictor"},
+	))
+	g.Expect(graph.Edges).Should(ConsistOf(
+		topology.Edge{
+			From:     "any",
+			To:       "workload:*/component=predictor",
+			Type:     topology.PolicyTypeAuthorization,
+			Policy:   "istio-system/kserve-predictor",
+			Action:   topology.ActionCustom,
+			Provider: "opendatahub-auth-provider",
+			Paths:    []string{"/v1/*"},
+		},
+		topology.Edge{
+			From:     "namespace:user-ns",
+			To:       "workload:*/component=predictor",
+			Type:     topology.PolicyTypeAuthorization,
+			Policy:   "istio-system/kserve-predictor",
+			Action:   topology.ActionCustom,
+			Provider: "opendatahub-auth-provider",
+		},
+	))
+}
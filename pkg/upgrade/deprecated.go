@@ -0,0 +1,317 @@
+package upgrade
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// DeprecatedAPIPolicy defines how the instances of the deprecated APIs left in the cluster are handled.
+type DeprecatedAPIPolicy string
+
+const (
+	// DeprecatedAPIPolicyReport only reports the instances, in the logs and in the DeprecatedAPIsRemoved
+	// condition of the DSCInitialization.
+	DeprecatedAPIPolicyReport DeprecatedAPIPolicy = "Report"
+	// DeprecatedAPIPolicyCleanup migrates the instances of the APIs which have a migration, and deletes
+	// the others.
+	DeprecatedAPIPolicyCleanup DeprecatedAPIPolicy = "Cleanup"
+)
+
+// ParseDeprecatedAPIPolicy validates the policy set on the command line.
+func ParseDeprecatedAPIPolicy(value string) (DeprecatedAPIPolicy, error) {
+	switch p := DeprecatedAPIPolicy(value); p {
+	case DeprecatedAPIPolicyReport, DeprecatedAPIPolicyCleanup:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid deprecated API policy %q, must be one of %s, %s",
+			value, DeprecatedAPIPolicyReport, DeprecatedAPIPolicyCleanup)
+	}
+}
+
+// MigrateFn converts an instance of a deprecated API to its replacement. The instance is deleted
+// once migrated.
+type MigrateFn func(ctx context.Context, cli client.Client, obj *unstructured.Unstructured) error
+
+// DeprecatedAPI is an API of a component which is not reconciled anymore since a given release. Its
+// CRD is usually left in the cluster, as CRDs are never removed by the operator, and so are the
+// instances created with an older release of the component.
+//
+// The instances are listed with the version of the API, which returns all the instances of the resource
+// whatever the version they were created with: the API is skipped while its CRD serves any other version,
+// so that the instances of a version still supported are never cleaned up.
+type DeprecatedAPI struct {
+	GVK schema.GroupVersionKind
+	// RemovedIn is the first release of the operator not supporting the API anymore, for each platform, as
+	// the platforms have their own versions. The API is skipped on the platforms not listed.
+	RemovedIn map[cluster.Platform]semver.Version
+	// Replacement describes the API to use instead, if any.
+	Replacement string
+	// Migrate, if set, converts the instances to the replacement API when they are cleaned up.
+	Migrate MigrateFn
+}
+
+// removedInAllPlatforms returns the same release for all the platforms.
+func removedInAllPlatforms(odh string, rhoai string) map[cluster.Platform]semver.Version {
+	return map[cluster.Platform]semver.Version{
+		cluster.OpenDataHub:      semver.MustParse(odh),
+		cluster.SelfManagedRhoai: semver.MustParse(rhoai),
+		cluster.ManagedRhoai:     semver.MustParse(rhoai),
+	}
+}
+
+// DeprecatedAPIs is the registry of the APIs removed from the platform.
+var DeprecatedAPIs = []DeprecatedAPI{
+	{
+		GVK:         schema.GroupVersionKind{Group: "kfdef.apps.kubeflow.org", Version: "v1", Kind: "KfDef"},
+		RemovedIn:   removedInAllPlatforms("2.0.0", "2.0.0"),
+		Replacement: "DataScienceCluster",
+	},
+	{
+		GVK:         schema.GroupVersionKind{Group: "workload.codeflare.dev", Version: "v1beta1", Kind: "AppWrapper"},
+		RemovedIn:   removedInAllPlatforms("2.10.0", "2.10.0"),
+		Replacement: "AppWrapper workload.codeflare.dev/v1beta2, admitted by Kueue",
+	},
+	{
+		GVK:       schema.GroupVersionKind{Group: "workload.codeflare.dev", Version: "v1beta1", Kind: "SchedulingSpec"},
+		RemovedIn: removedInAllPlatforms("2.10.0", "2.10.0"),
+	},
+}
+
+// DeprecatedInstances are the instances of a deprecated API found in the cluster.
+type DeprecatedInstances struct {
+	API DeprecatedAPI
+	// RemovedIn is the release of the current platform the API was removed in.
+	RemovedIn semver.Version
+	Items     []unstructured.Unstructured
+}
+
+// FindDeprecatedInstances lists the instances of the APIs of the registry removed in the current
+// release of the platform or before. APIs whose CRD is not installed anymore, or still serves other
+// versions, are skipped. All the APIs of the platform are checked for development builds, whose
+// version is unknown.
+func FindDeprecatedInstances(ctx context.Context, cli client.Client, current cluster.Release, registry []DeprecatedAPI) ([]DeprecatedInstances, error) {
+	log := logf.FromContext(ctx)
+	curr := current.Version.Version
+	found := make([]DeprecatedInstances, 0)
+
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := cli.List(ctx, &crds); err != nil {
+		return nil, fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)
+	}
+
+	for _, api := range registry {
+		removedIn, ok := api.RemovedIn[current.Name]
+		if !ok || (!curr.EQ(semver.Version{}) && removedIn.GT(curr)) {
+			continue
+		}
+
+		crd := findCRD(crds.Items, api.GVK.GroupKind())
+		if crd == nil {
+			continue
+		}
+
+		if served := otherServedVersions(crd, api.GVK.Version); len(served) != 0 {
+			log.V(1).Info("removed API skipped, its resource is still served", "gvk", api.GVK, "served", served)
+			continue
+		}
+
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(api.GVK.GroupVersion().WithKind(api.GVK.Kind + "List"))
+
+		err := cli.List(ctx, &items)
+		switch {
+		case meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to list %s: %w", api.GVK, err)
+		}
+
+		if len(items.Items) != 0 {
+			found = append(found, DeprecatedInstances{API: api, RemovedIn: removedIn, Items: items.Items})
+		}
+	}
+
+	return found, nil
+}
+
+func findCRD(crds []apiextensionsv1.CustomResourceDefinition, gk schema.GroupKind) *apiextensionsv1.CustomResourceDefinition {
+	for i := range crds {
+		if crds[i].Spec.Group == gk.Group && crds[i].Spec.Names.Kind == gk.Kind {
+			return &crds[i]
+		}
+	}
+
+	return nil
+}
+
+// otherServedVersions returns the versions served by the CRD other than the given one.
+func otherServedVersions(crd *apiextensionsv1.CustomResourceDefinition, version string) []string {
+	served := make([]string, 0)
+	for _, v := range crd.Spec.Versions {
+		if v.Served && v.Name != version {
+			served = append(served, v.Name)
+		}
+	}
+
+	return served
+}
+
+// HandleDeprecatedAPIs finds the instances of the deprecated APIs left in the cluster, cleans them
+// up if the policy allows it, and reports the ones which are left in the DeprecatedAPIsRemoved condition
+// of the DSCInitialization.
+func HandleDeprecatedAPIs(
+	ctx context.Context,
+	cli client.Client,
+	current cluster.Release,
+	policy DeprecatedAPIPolicy,
+	registry []DeprecatedAPI,
+) error {
+	log := logf.FromContext(ctx)
+
+	found, err := FindDeprecatedInstances(ctx, cli, current, registry)
+	if err != nil {
+		return err
+	}
+
+	var cleanupErr error
+
+	if policy == DeprecatedAPIPolicyCleanup {
+		found, cleanupErr = cleanupDeprecatedInstances(ctx, cli, found)
+	}
+
+	for _, f := range found {
+		for i := range f.Items {
+			log.Info("instance of a removed API found",
+				"gvk", f.API.GVK,
+				"name", client.ObjectKeyFromObject(&f.Items[i]),
+				"removedIn", f.RemovedIn,
+				"replacement", f.API.Replacement)
+		}
+	}
+
+	return errors.Join(cleanupErr, reportDeprecatedInstances(ctx, cli, found))
+}
+
+// cleanupDeprecatedInstances migrates or deletes the instances, and returns the ones which could not
+// be cleaned up.
+func cleanupDeprecatedInstances(ctx context.Context, cli client.Client, found []DeprecatedInstances) ([]DeprecatedInstances, error) {
+	log := logf.FromContext(ctx)
+	left := make([]DeprecatedInstances, 0)
+
+	var errs []error
+
+	for _, f := range found {
+		failed := DeprecatedInstances{API: f.API, RemovedIn: f.RemovedIn}
+
+		for i := range f.Items {
+			obj := &f.Items[i]
+
+			if err := cleanupDeprecatedInstance(ctx, cli, f.API, obj); err != nil {
+				errs = append(errs, err)
+				failed.Items = append(failed.Items, *obj)
+
+				continue
+			}
+
+			log.Info("instance of a removed API cleaned up", "gvk", f.API.GVK, "name", client.ObjectKeyFromObject(obj))
+		}
+
+		if len(failed.Items) != 0 {
+			left = append(left, failed)
+		}
+	}
+
+	return left, errors.Join(errs...)
+}
+
+func cleanupDeprecatedInstance(ctx context.Context, cli client.Client, api DeprecatedAPI, obj *unstructured.Unstructured) error {
+	if api.Migrate != nil {
+		if err := api.Migrate(ctx, cli, obj); err != nil {
+			return fmt.Errorf("failed to migrate %s %s: %w", api.GVK.Kind, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	// the controller which used to process the finalizers is gone, the deletion would hang forever
+	if len(obj.GetFinalizers()) != 0 {
+		patch := client.MergeFrom(obj.DeepCopy())
+		obj.SetFinalizers(nil)
+
+		if err := cli.Patch(ctx, obj, patch); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to remove finalizers of %s %s: %w", api.GVK.Kind, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	if err := cli.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", api.GVK.Kind, client.ObjectKeyFromObject(obj), err)
+	}
+
+	return nil
+}
+
+func reportDeprecatedInstances(ctx context.Context, cli client.Client, found []DeprecatedInstances) error {
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	message := deprecatedInstancesMessage(found)
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		_, err := status.UpdateWithRetry(ctx, cli, instance, func(saved *dsciv1.DSCInitialization) {
+			if len(found) == 0 {
+				conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionDeprecatedAPIs)
+				return
+			}
+
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+				Type:    status.ConditionDeprecatedAPIs,
+				Status:  corev1.ConditionFalse,
+				Reason:  status.DeprecatedAPIInstancesFoundReason,
+				Message: message,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update DSCInitialization %s: %w", instance.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func deprecatedInstancesMessage(found []DeprecatedInstances) string {
+	entries := make([]string, 0, len(found))
+	for _, f := range found {
+		entry := fmt.Sprintf("%d %s (removed in %s", len(f.Items), f.API.GVK.GroupKind(), f.RemovedIn)
+		if f.API.Replacement != "" {
+			entry += ", replaced by " + f.API.Replacement
+		}
+
+		entries = append(entries, entry+")")
+	}
+
+	slices.SortFunc(entries, func(a, b string) int {
+		return cmp.Compare(a, b)
+	})
+
+	return fmt.Sprintf("instances of removed APIs are left in the cluster: %s", strings.Join(entries, "; "))
+}
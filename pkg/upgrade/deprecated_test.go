@@ -0,0 +1,170 @@
+package upgrade_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+
+	. "github.com/onsi/gomega"
+)
+
+var (
+	legacyGVK  = schema.GroupVersionKind{Group: "legacy.opendatahub.io", Version: "v1", Kind: "Legacy"}
+	removedGVK = schema.GroupVersionKind{Group: "removed.opendatahub.io", Version: "v1", Kind: "Removed"}
+)
+
+func newLegacy(name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(legacyGVK)
+	obj.SetName(name)
+	obj.SetNamespace("user-project")
+	obj.SetFinalizers([]string{"legacy.opendatahub.io/finalizer"})
+
+	return obj
+}
+
+// newCRD returns the CRD of the legacy API serving the given versions.
+func newCRD(versions ...string) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	crd.SetName("legacies." + legacyGVK.Group)
+	crd.Spec.Group = legacyGVK.Group
+	crd.Spec.Names.Kind = legacyGVK.Kind
+
+	for _, v := range versions {
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: v, Served: true})
+	}
+
+	return crd
+}
+
+func newDeprecatedClient(t *testing.T, crd *apiextensionsv1.CustomResourceDefinition) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(dsciv1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	scheme.AddKnownTypeWithName(legacyGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(legacyGVK.GroupVersion().WithKind("LegacyList"), &unstructured.UnstructuredList{})
+
+	dsci := &dsciv1.DSCInitialization{}
+	dsci.SetName("default-dsci")
+
+	return clientFake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dsciv1.DSCInitialization{}).
+		WithObjects(dsci, crd, newLegacy("first"), newLegacy("second")).
+		Build()
+}
+
+func TestHandleDeprecatedAPIs(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		policy    upgrade.DeprecatedAPIPolicy
+		current   string
+		platform  cluster.Platform
+		versions  []string
+		remaining int
+		migrated  int
+		condition bool
+	}{
+		"report":                     {policy: upgrade.DeprecatedAPIPolicyReport, current: "2.20.0", remaining: 2, condition: true},
+		"cleanup":                    {policy: upgrade.DeprecatedAPIPolicyCleanup, current: "2.20.0", remaining: 0, migrated: 2},
+		"development build":          {policy: upgrade.DeprecatedAPIPolicyCleanup, current: "0.0.0", remaining: 0, migrated: 2},
+		"not removed in the release": {policy: upgrade.DeprecatedAPIPolicyCleanup, current: "2.9.0", remaining: 2},
+		"resource still served":      {policy: upgrade.DeprecatedAPIPolicyCleanup, current: "2.20.0", versions: []string{"v1", "v2"}, remaining: 2},
+		"release of another platform": {
+			policy:    upgrade.DeprecatedAPIPolicyCleanup,
+			current:   "2.10.0",
+			platform:  cluster.SelfManagedRhoai,
+			remaining: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			versions := tt.versions
+			if len(versions) == 0 {
+				versions = []string{legacyGVK.Version}
+			}
+
+			cli := newDeprecatedClient(t, newCRD(versions...))
+
+			current := release(tt.current)
+			if tt.platform != "" {
+				current.Name = tt.platform
+			}
+
+			migrated := 0
+			registry := []upgrade.DeprecatedAPI{
+				{
+					GVK: legacyGVK,
+					RemovedIn: map[cluster.Platform]semver.Version{
+						cluster.OpenDataHub:      semver.MustParse("2.10.0"),
+						cluster.SelfManagedRhoai: semver.MustParse("2.12.0"),
+					},
+					Replacement: "Modern",
+					Migrate: func(_ context.Context, _ client.Client, _ *unstructured.Unstructured) error {
+						migrated++
+						return nil
+					},
+				},
+				{
+					GVK:       removedGVK,
+					RemovedIn: map[cluster.Platform]semver.Version{cluster.OpenDataHub: semver.MustParse("2.0.0")},
+				},
+			}
+
+			err := upgrade.HandleDeprecatedAPIs(ctx, cli, current, tt.policy, registry)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(migrated).Should(Equal(tt.migrated))
+
+			items := unstructured.UnstructuredList{}
+			items.SetGroupVersionKind(legacyGVK.GroupVersion().WithKind("LegacyList"))
+			g.Expect(cli.List(ctx, &items)).Should(Succeed())
+			g.Expect(items.Items).Should(HaveLen(tt.remaining))
+
+			dsci := dsciv1.DSCInitialization{}
+			g.Expect(cli.Get(ctx, client.ObjectKey{Name: "default-dsci"}, &dsci)).Should(Succeed())
+
+			condition := conditionsv1.FindStatusCondition(dsci.Status.Conditions, status.ConditionDeprecatedAPIs)
+			if !tt.condition {
+				g.Expect(condition).Should(BeNil())
+				return
+			}
+
+			g.Expect(condition).ShouldNot(BeNil())
+			g.Expect(condition.Status).Should(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).Should(Equal(status.DeprecatedAPIInstancesFoundReason))
+			g.Expect(condition.Message).Should(ContainSubstring("2 Legacy.legacy.opendatahub.io (removed in 2.10.0, replaced by Modern)"))
+		})
+	}
+}
+
+func TestParseDeprecatedAPIPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	p, err := upgrade.ParseDeprecatedAPIPolicy("Cleanup")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(p).Should(Equal(upgrade.DeprecatedAPIPolicyCleanup))
+
+	_, err = upgrade.ParseDeprecatedAPIPolicy("Delete")
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -0,0 +1,98 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+const (
+	// MaxMinorVersionSkew is the maximum number of minor versions the operator can be upgraded across
+	// at once. The cleanup of the resources deprecated by a release is removed from the operator a few
+	// releases later, so skipping more versions leaves them behind or half migrated.
+	MaxMinorVersionSkew = 3
+
+	// UpgradePathDocs documents the supported upgrade paths.
+	UpgradePathDocs = "https://github.com/opendatahub-io/opendatahub-operator/blob/main/docs/troubleshooting.md#the-operator-refuses-to-start-after-an-upgrade"
+)
+
+var ErrUnsupportedUpgradePath = errors.New("unsupported upgrade path")
+
+// CheckVersionSkew returns an error wrapping ErrUnsupportedUpgradePath if the operator can not be
+// upgraded from the previous release to the current one directly. Fresh installations and development
+// builds, whose version is unknown, are always allowed.
+func CheckVersionSkew(previous cluster.Release, current cluster.Release) error {
+	prev := previous.Version.Version
+	curr := current.Version.Version
+
+	if prev.EQ(semver.Version{}) || curr.EQ(semver.Version{}) || !curr.GT(prev) {
+		return nil
+	}
+
+	if prev.Major != curr.Major {
+		return fmt.Errorf("%w: upgrading from %s to %s crosses a major version, upgrade to the latest %d.x release first",
+			ErrUnsupportedUpgradePath, prev, curr, prev.Major)
+	}
+
+	if curr.Minor-prev.Minor > MaxMinorVersionSkew {
+		return fmt.Errorf("%w: upgrading from %s to %s skips more than %d minor versions, upgrade to %d.%d first",
+			ErrUnsupportedUpgradePath, prev, curr, MaxMinorVersionSkew, prev.Major, prev.Minor+MaxMinorVersionSkew)
+	}
+
+	return nil
+}
+
+// EnforceVersionSkew checks that the release recorded in the status of the DSCInitialization can be
+// upgraded to the current one, and reports the result in its Upgradeable condition so that the reason
+// the operator refuses to start is visible on the cluster.
+func EnforceVersionSkew(ctx context.Context, cli client.Client, current cluster.Release) error {
+	previous, err := GetDeployedRelease(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("failed to get the deployed release: %w", err)
+	}
+
+	skewErr := CheckVersionSkew(previous, current)
+
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return errors.Join(skewErr, fmt.Errorf("failed to list DSCInitialization: %w", err))
+	}
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		_, err := status.UpdateWithRetry(ctx, cli, instance, func(saved *dsciv1.DSCInitialization) {
+			if skewErr == nil {
+				// the Upgradeable condition may be reported for other reasons, only the one set
+				// for the upgrade path is cleared
+				c := conditionsv1.FindStatusCondition(saved.Status.Conditions, conditionsv1.ConditionUpgradeable)
+				if c != nil && c.Reason == status.UnsupportedUpgradePathReason {
+					conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, conditionsv1.ConditionUpgradeable)
+				}
+
+				return
+			}
+
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+				Type:    conditionsv1.ConditionUpgradeable,
+				Status:  corev1.ConditionFalse,
+				Reason:  status.UnsupportedUpgradePathReason,
+				Message: fmt.Sprintf("%s, see %s", skewErr.Error(), UpgradePathDocs),
+			})
+		})
+		if err != nil {
+			return errors.Join(skewErr, fmt.Errorf("failed to update DSCInitialization %s: %w", instance.Name, err))
+		}
+	}
+
+	return skewErr
+}
@@ -0,0 +1,143 @@
+package upgrade_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/operator-framework/api/pkg/lib/version"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+
+	. "github.com/onsi/gomega"
+)
+
+func release(v string) cluster.Release {
+	return cluster.Release{
+		Name:    cluster.OpenDataHub,
+		Version: version.OperatorVersion{Version: semver.MustParse(v)},
+	}
+}
+
+func TestCheckVersionSkew(t *testing.T) {
+	tests := map[string]struct {
+		previous  string
+		current   string
+		supported bool
+	}{
+		"fresh installation":     {previous: "0.0.0", current: "2.20.0", supported: true},
+		"development build":      {previous: "2.10.0", current: "0.0.0", supported: true},
+		"same version":           {previous: "2.20.0", current: "2.20.0", supported: true},
+		"patch release":          {previous: "2.20.0", current: "2.20.3", supported: true},
+		"next minor":             {previous: "2.20.1", current: "2.21.0", supported: true},
+		"maximum skew":           {previous: "2.17.0", current: "2.20.0", supported: true},
+		"downgrade":              {previous: "2.20.0", current: "2.10.0", supported: true},
+		"more than maximum skew": {previous: "2.16.2", current: "2.20.0", supported: false},
+		"major version":          {previous: "1.9.0", current: "2.0.0", supported: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := upgrade.CheckVersionSkew(release(tt.previous), release(tt.current))
+			if tt.supported {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			} else {
+				g.Expect(err).Should(MatchError(upgrade.ErrUnsupportedUpgradePath))
+			}
+		})
+	}
+}
+
+func TestCheckVersionSkewPath(t *testing.T) {
+	g := NewWithT(t)
+
+	err := upgrade.CheckVersionSkew(release("2.10.0"), release("2.20.0"))
+	g.Expect(err).Should(MatchError(ContainSubstring("upgrade to 2.13 first")))
+}
+
+func TestEnforceVersionSkew(t *testing.T) {
+	ctx := context.Background()
+
+	// the deployed release is 2.16.0
+	tests := map[string]struct {
+		current   string
+		reason    string
+		supported bool
+		expected  string
+	}{
+		"unsupported upgrade path": {
+			current:  "2.20.0",
+			expected: status.UnsupportedUpgradePathReason,
+		},
+		"supported upgrade path": {
+			current:   "2.17.0",
+			reason:    status.UnsupportedUpgradePathReason,
+			supported: true,
+		},
+		"not upgradeable for another reason": {
+			current:   "2.17.0",
+			reason:    "SomeOtherReason",
+			supported: true,
+			expected:  "SomeOtherReason",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			instance := dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+			instance.Status.Release = release("2.16.0")
+
+			if tt.reason != "" {
+				conditionsv1.SetStatusCondition(&instance.Status.Conditions, conditionsv1.Condition{
+					Type:   conditionsv1.ConditionUpgradeable,
+					Status: corev1.ConditionFalse,
+					Reason: tt.reason,
+				})
+			}
+
+			scheme := runtime.NewScheme()
+			utilruntime.Must(dsciv1.AddToScheme(scheme))
+			utilruntime.Must(dscv1.AddToScheme(scheme))
+
+			cli := clientFake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&dsciv1.DSCInitialization{}).
+				WithObjects(&instance).
+				Build()
+
+			err := upgrade.EnforceVersionSkew(ctx, cli, release(tt.current))
+			if tt.supported {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			} else {
+				g.Expect(err).Should(MatchError(upgrade.ErrUnsupportedUpgradePath))
+			}
+
+			saved := dsciv1.DSCInitialization{}
+			g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(&instance), &saved)).Should(Succeed())
+
+			c := conditionsv1.FindStatusCondition(saved.Status.Conditions, conditionsv1.ConditionUpgradeable)
+			if tt.expected == "" {
+				g.Expect(c).Should(BeNil())
+				return
+			}
+
+			g.Expect(c).ShouldNot(BeNil())
+			g.Expect(c.Reason).Should(Equal(tt.expected))
+		})
+	}
+}
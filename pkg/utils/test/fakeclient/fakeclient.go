@@ -1,16 +1,20 @@
 package fakeclient
 
 import (
+	"context"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	k8sFake "k8s.io/client-go/kubernetes/fake"
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/client"
@@ -44,6 +48,20 @@ func New(objs ...ctrlClient.Object) (*client.Client, error) {
 			WithScheme(scheme).
 			WithRESTMapper(fakeMapper).
 			WithObjects(objs...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, cli ctrlClient.WithWatch, key ctrlClient.ObjectKey, obj ctrlClient.Object, opts ...ctrlClient.GetOption) error {
+					// the fake client does not check the kind of unstructured objects, as the
+					// api server does for the kinds whose CRD is not installed
+					if u, ok := obj.(*unstructured.Unstructured); ok {
+						objGVK := u.GroupVersionKind()
+						if _, err := cli.RESTMapper().RESTMapping(objGVK.GroupKind(), objGVK.Version); err != nil {
+							return err
+						}
+					}
+
+					return cli.Get(ctx, key, obj, opts...)
+				},
+			}).
 			Build(),
 		k8sFake.NewSimpleClientset(ro...),
 		dynamicFake.NewSimpleDynamicClient(scheme, ro...),
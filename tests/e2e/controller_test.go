@@ -30,6 +30,7 @@ import (
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/framework"
 )
 
 type TestFn func(t *testing.T)
@@ -77,6 +78,8 @@ type testContextConfig struct {
 
 // Holds information specific to individual tests.
 type testContext struct {
+	*framework.TestContext
+
 	// Rest config
 	cfg *rest.Config
 	// client for k8s resources
@@ -119,17 +122,20 @@ func NewTestContext() (*testContext, error) {
 	}
 
 	// setup DSCI CR since we do not create automatically by operator
-	testDSCI := setupDSCICR("e2e-test-dsci")
+	testDSCI := framework.NewDSCI("e2e-test-dsci")
 	// Setup DataScienceCluster CR
-	testDSC := setupDSCInstance("e2e-test-dsc")
+	testDSC := framework.NewDSC("e2e-test-dsc")
+
+	ctx := context.TODO()
 
 	return &testContext{
+		TestContext:           framework.NewTestContext(ctx, custClient),
 		cfg:                   config,
 		kubeClient:            kc,
 		customClient:          custClient,
 		operatorNamespace:     testOpts.operatorNamespace,
 		applicationsNamespace: testDSCI.Spec.ApplicationsNamespace,
-		ctx:                   context.TODO(),
+		ctx:                   ctx,
 		testDsc:               testDSC,
 		testDSCI:              testDSCI,
 		testOpts:              testOpts,
@@ -24,6 +24,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/modelregistry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/framework"
 )
 
 func creationTestSuite(t *testing.T) {
@@ -165,23 +166,7 @@ func (tc *testContext) validateDSCReady() error {
 // Verify DSC instance is in Ready phase when all components are up and running
 
 func waitDSCReady(tc *testContext) error {
-	// wait for 2 mins which is on the safe side, normally it should get ready once all components are ready
-	err := tc.wait(func(ctx context.Context) (bool, error) {
-		key := types.NamespacedName{Name: tc.testDsc.Name}
-		dsc := &dscv1.DataScienceCluster{}
-
-		err := tc.customClient.Get(ctx, key, dsc)
-		if err != nil {
-			return false, err
-		}
-		return dsc.Status.Phase == readyStatus, nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("Error waiting Ready state for DSC %v: %w", tc.testDsc.Name, err)
-	}
-
-	return nil
+	return framework.WaitForDSCReady(tc.ctx, tc.customClient, tc.testDsc.Name)
 }
 
 func (tc *testContext) requireInstalled(t *testing.T, gvk schema.GroupVersionKind) {
@@ -218,7 +203,7 @@ func (tc *testContext) testDSCIDuplication(t *testing.T) { //nolint:thelper
 		Version: "v1",
 		Kind:    "DSCInitialization",
 	}
-	dup := setupDSCICR("e2e-test-dsci-dup")
+	dup := framework.NewDSCI("e2e-test-dsci-dup")
 
 	tc.testDuplication(t, gvk, dup)
 }
@@ -229,7 +214,7 @@ func (tc *testContext) testDSCDuplication(t *testing.T) { //nolint:thelper
 		Version: "v1",
 		Kind:    "DataScienceCluster",
 	}
-	dup := setupDSCInstance("e2e-test-dsc-dup")
+	dup := framework.NewDSC("e2e-test-dsc-dup")
 
 	tc.testDuplication(t, gvk, dup)
 }
@@ -1,7 +1,6 @@
 package e2e_test
 
 import (
-	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -12,7 +11,6 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,136 +30,6 @@ type DashboardTestCtx struct {
 	*testContext
 }
 
-func (d *DashboardTestCtx) WithT(t *testing.T) *WithT {
-	t.Helper()
-
-	g := NewWithT(t)
-	g.SetDefaultEventuallyTimeout(generalWaitTimeout)
-	g.SetDefaultEventuallyPollingInterval(1 * time.Second)
-
-	return g
-}
-
-func (d *DashboardTestCtx) List(
-	gvk schema.GroupVersionKind,
-	option ...client.ListOption,
-) func() ([]unstructured.Unstructured, error) {
-	return func() ([]unstructured.Unstructured, error) {
-		items := unstructured.UnstructuredList{}
-		items.SetGroupVersionKind(gvk)
-
-		err := d.customClient.List(d.ctx, &items, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return items.Items, nil
-	}
-}
-
-func (d *DashboardTestCtx) Get(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u := unstructured.Unstructured{}
-		u.SetGroupVersionKind(gvk)
-
-		err := d.customClient.Get(d.ctx, client.ObjectKey{Namespace: ns, Name: name}, &u, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return &u, nil
-	}
-}
-
-func (d *DashboardTestCtx) Delete(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.DeleteOption,
-) func() error {
-	return func() error {
-		u := resources.GvkToUnstructured(gvk)
-		u.SetName(name)
-		u.SetNamespace(ns)
-
-		err := d.customClient.Delete(d.ctx, u, option...)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
-func (d *DashboardTestCtx) Update(
-	obj client.Object,
-	fn func(obj *unstructured.Unstructured) error,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		if err := d.customClient.Get(d.ctx, client.ObjectKeyFromObject(obj), obj, option...); err != nil {
-			return nil, fmt.Errorf("failed to fetch resource: %w", err)
-		}
-
-		in, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert to unstructured: %w", err)
-		}
-
-		if err := fn(in); err != nil {
-			return nil, fmt.Errorf("failed to apply function: %w", err)
-		}
-
-		if err := d.customClient.Update(d.ctx, in); err != nil {
-			return nil, fmt.Errorf("failed to update resource: %w", err)
-		}
-
-		return in, nil
-	}
-}
-
-func (d *DashboardTestCtx) MergePatch(
-	obj client.Object,
-	patch []byte,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, err
-		}
-
-		err = d.customClient.Patch(d.ctx, u, client.RawPatch(types.MergePatchType, patch))
-		if err != nil {
-			return nil, err
-		}
-
-		return u, nil
-	}
-}
-
-func (d *DashboardTestCtx) updateComponent(fn func(dsc *dscv1.Components)) func() error {
-	return func() error {
-		err := d.customClient.Get(d.ctx, types.NamespacedName{Name: d.testDsc.Name}, d.testDsc)
-		if err != nil {
-			return err
-		}
-
-		fn(&d.testDsc.Spec.Components)
-
-		err = d.customClient.Update(d.ctx, d.testDsc)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
 func (d *DashboardTestCtx) getInstance() (*componentApi.Dashboard, error) {
 	mri := componentApi.Dashboard{}
 	nn := types.NamespacedName{Name: componentApi.DashboardInstanceName}
@@ -199,7 +67,7 @@ func (d *DashboardTestCtx) validateDashboardInstance(t *testing.T) {
 	g := d.WithT(t)
 
 	g.Eventually(
-		d.updateComponent(func(c *dscv1.Components) {
+		d.UpdateComponents(d.testDsc, func(c *dscv1.Components) {
 			c.Dashboard.ManagementState = operatorv1.Managed
 		}),
 	).ShouldNot(
@@ -349,7 +217,7 @@ func (d *DashboardTestCtx) validateCRDReinstated(t *testing.T) {
 	crdSel := client.MatchingFields{"metadata.name": crdName}
 
 	g.Eventually(
-		d.updateComponent(func(c *dscv1.Components) { c.Dashboard.ManagementState = operatorv1.Removed }),
+		d.UpdateComponents(d.testDsc, func(c *dscv1.Components) { c.Dashboard.ManagementState = operatorv1.Removed }),
 	).ShouldNot(
 		HaveOccurred(),
 	)
@@ -383,7 +251,7 @@ func (d *DashboardTestCtx) validateCRDReinstated(t *testing.T) {
 	)
 
 	g.Eventually(
-		d.updateComponent(func(c *dscv1.Components) { c.Dashboard.ManagementState = operatorv1.Managed }),
+		d.UpdateComponents(d.testDsc, func(c *dscv1.Components) { c.Dashboard.ManagementState = operatorv1.Managed }),
 	).ShouldNot(
 		HaveOccurred(),
 	)
@@ -415,7 +283,7 @@ func (d *DashboardTestCtx) validateDashboardDisabled(t *testing.T) {
 	)
 
 	g.Eventually(
-		d.updateComponent(func(c *dscv1.Components) {
+		d.UpdateComponents(d.testDsc, func(c *dscv1.Components) {
 			c.Dashboard.ManagementState = operatorv1.Removed
 		}),
 	).ShouldNot(
@@ -9,10 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
@@ -21,7 +18,6 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/modelcontroller"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 
 	. "github.com/onsi/gomega"
@@ -55,7 +51,7 @@ func (k *KserveTestCtx) validateKserveInstance(t *testing.T) {
 	g := k.WithT(t)
 
 	g.Eventually(
-		k.updateComponent(func(c *dscv1.Components) {
+		k.UpdateComponents(k.testDsc, func(c *dscv1.Components) {
 			c.Kserve.ManagementState = operatorv1.Managed
 		}),
 	).ShouldNot(
@@ -109,7 +105,7 @@ func (k *KserveTestCtx) validateOperandsOwnerReferences(t *testing.T) {
 	g := k.WithT(t)
 
 	g.Eventually(
-		k.updateComponent(func(c *dscv1.Components) {
+		k.UpdateComponents(k.testDsc, func(c *dscv1.Components) {
 			c.Kserve.ManagementState = operatorv1.Managed
 		}),
 	).ShouldNot(
@@ -225,7 +221,7 @@ func (k *KserveTestCtx) validateKserveDisabled(t *testing.T) {
 	)
 
 	g.Eventually(
-		k.updateComponent(func(c *dscv1.Components) {
+		k.UpdateComponents(k.testDsc, func(c *dscv1.Components) {
 			c.Kserve.ManagementState = operatorv1.Removed
 		}),
 	).ShouldNot(
@@ -257,85 +253,3 @@ func (k *KserveTestCtx) validateKserveDisabled(t *testing.T) {
 		),
 	))
 }
-
-func (k *KserveTestCtx) WithT(t *testing.T) *WithT {
-	t.Helper()
-
-	g := NewWithT(t)
-	g.SetDefaultEventuallyTimeout(generalWaitTimeout)
-	g.SetDefaultEventuallyPollingInterval(1 * time.Second)
-
-	return g
-}
-
-func (k *KserveTestCtx) List(
-	gvk schema.GroupVersionKind,
-	option ...client.ListOption,
-) func() ([]unstructured.Unstructured, error) {
-	return func() ([]unstructured.Unstructured, error) {
-		items := unstructured.UnstructuredList{}
-		items.SetGroupVersionKind(gvk)
-
-		err := k.customClient.List(k.ctx, &items, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return items.Items, nil
-	}
-}
-
-func (k *KserveTestCtx) Get(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u := unstructured.Unstructured{}
-		u.SetGroupVersionKind(gvk)
-
-		err := k.customClient.Get(k.ctx, client.ObjectKey{Namespace: ns, Name: name}, &u, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return &u, nil
-	}
-}
-func (k *KserveTestCtx) MergePatch(
-	obj client.Object,
-	patch []byte,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.customClient.Patch(k.ctx, u, client.RawPatch(types.MergePatchType, patch))
-		if err != nil {
-			return nil, err
-		}
-
-		return u, nil
-	}
-}
-
-func (k *KserveTestCtx) updateComponent(fn func(dsc *dscv1.Components)) func() error {
-	return func() error {
-		err := k.customClient.Get(k.ctx, types.NamespacedName{Name: k.testDsc.Name}, k.testDsc)
-		if err != nil {
-			return err
-		}
-
-		fn(&k.testDsc.Spec.Components)
-
-		err = k.customClient.Update(k.ctx, k.testDsc)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
@@ -1,7 +1,6 @@
 package e2e_test
 
 import (
-	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -10,9 +9,6 @@ import (
 	"github.com/stretchr/testify/require"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/apis/components/v1alpha1"
@@ -20,7 +16,6 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/components/modelcontroller"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 
 	. "github.com/onsi/gomega"
@@ -49,141 +44,11 @@ type ModelControllerTestCtx struct {
 	*testContext
 }
 
-func (tc *ModelControllerTestCtx) WithT(t *testing.T) *WithT {
-	t.Helper()
-
-	g := NewWithT(t)
-	g.SetDefaultEventuallyTimeout(generalWaitTimeout)
-	g.SetDefaultEventuallyPollingInterval(1 * time.Second)
-
-	return g
-}
-
-func (tc *ModelControllerTestCtx) List(
-	gvk schema.GroupVersionKind,
-	option ...client.ListOption,
-) func() ([]unstructured.Unstructured, error) {
-	return func() ([]unstructured.Unstructured, error) {
-		items := unstructured.UnstructuredList{}
-		items.SetGroupVersionKind(gvk)
-
-		err := tc.customClient.List(tc.ctx, &items, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return items.Items, nil
-	}
-}
-
-func (tc *ModelControllerTestCtx) Get(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u := unstructured.Unstructured{}
-		u.SetGroupVersionKind(gvk)
-
-		err := tc.customClient.Get(tc.ctx, client.ObjectKey{Namespace: ns, Name: name}, &u, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return &u, nil
-	}
-}
-
-func (tc *ModelControllerTestCtx) Update(
-	obj client.Object,
-	fn func(obj *unstructured.Unstructured) error,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		if err := tc.customClient.Get(tc.ctx, client.ObjectKeyFromObject(obj), obj, option...); err != nil {
-			return nil, fmt.Errorf("failed to fetch resource: %w", err)
-		}
-
-		in, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert to unstructured: %w", err)
-		}
-
-		if err := fn(in); err != nil {
-			return nil, fmt.Errorf("failed to apply function: %w", err)
-		}
-
-		if err := tc.customClient.Update(tc.ctx, in); err != nil {
-			return nil, fmt.Errorf("failed to update resource: %w", err)
-		}
-
-		return in, nil
-	}
-}
-
-func (tc *ModelControllerTestCtx) Delete(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.DeleteOption,
-) func() error {
-	return func() error {
-		u := resources.GvkToUnstructured(gvk)
-		u.SetName(name)
-		u.SetNamespace(ns)
-
-		err := tc.customClient.Delete(tc.ctx, u, option...)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
-func (tc *ModelControllerTestCtx) MergePatch(
-	obj client.Object,
-	patch []byte,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, err
-		}
-
-		err = tc.customClient.Patch(tc.ctx, u, client.RawPatch(types.MergePatchType, patch))
-		if err != nil {
-			return nil, err
-		}
-
-		return u, nil
-	}
-}
-
-func (tc *ModelControllerTestCtx) updateComponent(fn func(dsc *dscv1.Components)) func() error {
-	return func() error {
-		err := tc.customClient.Get(tc.ctx, types.NamespacedName{Name: tc.testDsc.Name}, tc.testDsc)
-		if err != nil {
-			return err
-		}
-
-		fn(&tc.testDsc.Spec.Components)
-
-		err = tc.customClient.Update(tc.ctx, tc.testDsc)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
 func (tc *ModelControllerTestCtx) validateModelControllerInstance(t *testing.T) {
 	g := tc.WithT(t)
 
 	g.Eventually(
-		tc.updateComponent(func(c *dscv1.Components) {
+		tc.UpdateComponents(tc.testDsc, func(c *dscv1.Components) {
 			c.ModelMeshServing.ManagementState = operatorv1.Managed
 		}),
 	).ShouldNot(
@@ -299,7 +164,7 @@ func (tc *ModelControllerTestCtx) validateModelControllerDisabled(t *testing.T)
 	)
 
 	g.Eventually(
-		tc.updateComponent(func(c *dscv1.Components) {
+		tc.UpdateComponents(tc.testDsc, func(c *dscv1.Components) {
 			c.ModelMeshServing.ManagementState = operatorv1.Removed
 			c.Kserve.ManagementState = operatorv1.Removed
 		}),
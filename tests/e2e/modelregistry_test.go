@@ -1,7 +1,6 @@
 package e2e_test
 
 import (
-	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -12,7 +11,6 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -33,136 +31,6 @@ type ModelRegistryTestCtx struct {
 	*testContext
 }
 
-func (mr *ModelRegistryTestCtx) WithT(t *testing.T) *WithT {
-	t.Helper()
-
-	g := NewWithT(t)
-	g.SetDefaultEventuallyTimeout(generalWaitTimeout)
-	g.SetDefaultEventuallyPollingInterval(1 * time.Second)
-
-	return g
-}
-
-func (mr *ModelRegistryTestCtx) List(
-	gvk schema.GroupVersionKind,
-	option ...client.ListOption,
-) func() ([]unstructured.Unstructured, error) {
-	return func() ([]unstructured.Unstructured, error) {
-		items := unstructured.UnstructuredList{}
-		items.SetGroupVersionKind(gvk)
-
-		err := mr.customClient.List(mr.ctx, &items, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return items.Items, nil
-	}
-}
-
-func (mr *ModelRegistryTestCtx) Get(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u := unstructured.Unstructured{}
-		u.SetGroupVersionKind(gvk)
-
-		err := mr.customClient.Get(mr.ctx, client.ObjectKey{Namespace: ns, Name: name}, &u, option...)
-		if err != nil {
-			return nil, err
-		}
-
-		return &u, nil
-	}
-}
-
-func (mr *ModelRegistryTestCtx) Update(
-	obj client.Object,
-	fn func(obj *unstructured.Unstructured) error,
-	option ...client.GetOption,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		if err := mr.customClient.Get(mr.ctx, client.ObjectKeyFromObject(obj), obj, option...); err != nil {
-			return nil, fmt.Errorf("failed to fetch resource: %w", err)
-		}
-
-		in, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert to unstructured: %w", err)
-		}
-
-		if err := fn(in); err != nil {
-			return nil, fmt.Errorf("failed to apply function: %w", err)
-		}
-
-		if err := mr.customClient.Update(mr.ctx, in); err != nil {
-			return nil, fmt.Errorf("failed to update resource: %w", err)
-		}
-
-		return in, nil
-	}
-}
-
-func (mr *ModelRegistryTestCtx) Delete(
-	gvk schema.GroupVersionKind,
-	ns string,
-	name string,
-	option ...client.DeleteOption,
-) func() error {
-	return func() error {
-		u := resources.GvkToUnstructured(gvk)
-		u.SetName(name)
-		u.SetNamespace(ns)
-
-		err := mr.customClient.Delete(mr.ctx, u, option...)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
-func (mr *ModelRegistryTestCtx) MergePatch(
-	obj client.Object,
-	patch []byte,
-) func() (*unstructured.Unstructured, error) {
-	return func() (*unstructured.Unstructured, error) {
-		u, err := resources.ToUnstructured(obj)
-		if err != nil {
-			return nil, err
-		}
-
-		err = mr.customClient.Patch(mr.ctx, u, client.RawPatch(types.MergePatchType, patch))
-		if err != nil {
-			return nil, err
-		}
-
-		return u, nil
-	}
-}
-
-func (mr *ModelRegistryTestCtx) updateComponent(fn func(dsc *dscv1.Components)) func() error {
-	return func() error {
-		err := mr.customClient.Get(mr.ctx, types.NamespacedName{Name: mr.testDsc.Name}, mr.testDsc)
-		if err != nil {
-			return err
-		}
-
-		fn(&mr.testDsc.Spec.Components)
-
-		err = mr.customClient.Update(mr.ctx, mr.testDsc)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-}
-
 func (mr *ModelRegistryTestCtx) getInstance() (*componentApi.ModelRegistry, error) {
 	mri := componentApi.ModelRegistry{}
 	nn := types.NamespacedName{Name: componentApi.ModelRegistryInstanceName}
@@ -203,7 +71,7 @@ func (mr *ModelRegistryTestCtx) validateModelRegistryInstance(t *testing.T) {
 	g := mr.WithT(t)
 
 	g.Eventually(
-		mr.updateComponent(func(c *dscv1.Components) {
+		mr.UpdateComponents(mr.testDsc, func(c *dscv1.Components) {
 			c.ModelRegistry.ManagementState = operatorv1.Managed
 		}),
 	).ShouldNot(
@@ -401,7 +269,7 @@ func (mr *ModelRegistryTestCtx) validateCRDReinstated(t *testing.T) {
 	crdSel := client.MatchingFields{"metadata.name": crdName}
 
 	g.Eventually(
-		mr.updateComponent(func(c *dscv1.Components) { c.ModelRegistry.ManagementState = operatorv1.Removed }),
+		mr.UpdateComponents(mr.testDsc, func(c *dscv1.Components) { c.ModelRegistry.ManagementState = operatorv1.Removed }),
 	).ShouldNot(
 		HaveOccurred(),
 	)
@@ -435,7 +303,7 @@ func (mr *ModelRegistryTestCtx) validateCRDReinstated(t *testing.T) {
 	)
 
 	g.Eventually(
-		mr.updateComponent(func(c *dscv1.Components) { c.ModelRegistry.ManagementState = operatorv1.Managed }),
+		mr.UpdateComponents(mr.testDsc, func(c *dscv1.Components) { c.ModelRegistry.ManagementState = operatorv1.Managed }),
 	).ShouldNot(
 		HaveOccurred(),
 	)
@@ -467,7 +335,7 @@ func (mr *ModelRegistryTestCtx) validateModelRegistryDisabled(t *testing.T) {
 	)
 
 	g.Eventually(
-		mr.updateComponent(func(c *dscv1.Components) {
+		mr.UpdateComponents(mr.testDsc, func(c *dscv1.Components) {
 			c.ModelRegistry.ManagementState = operatorv1.Removed
 		}),
 	).ShouldNot(
@@ -0,0 +1,186 @@
+// Package framework provides the building blocks of the end-to-end tests of the operator, so that
+// distributions built on top of it can write their own suites against a cluster without copying them:
+//   - constructors for the DSCInitialization, DataScienceCluster and OLM resources,
+//   - waiters for the readiness of the operator and of the DSC/DSCI conditions,
+//   - a TestContext exposing gomega friendly accessors and the toggling of components.
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	DefaultRetryInterval     = 10 * time.Second
+	DefaultWaitTimeout       = 2 * time.Minute
+	DefaultPollingInterval   = 1 * time.Second
+	OperatorReadyTimeout     = 2 * time.Minute
+	ComponentReadyTimeout    = 7 * time.Minute // in component code is to set 2-3 mins, keep it 7 mins just the same value we used after introduce "Ready" check
+	ComponentDeletionTimeout = 1 * time.Minute
+	CRDReadyTimeout          = 1 * time.Minute
+	CSVWaitTimeout           = 1 * time.Minute
+)
+
+// TestContext gives access to the resources of the cluster under test. The accessors return functions,
+// so that they can be polled with gomega's Eventually and Consistently.
+type TestContext struct {
+	//nolint:containedctx //reason: the context is shared by all the assertions of a suite
+	ctx    context.Context
+	client client.Client
+}
+
+func NewTestContext(ctx context.Context, cli client.Client) *TestContext {
+	return &TestContext{
+		ctx:    ctx,
+		client: cli,
+	}
+}
+
+// WithT returns a gomega instance whose Eventually defaults fit the time the operator needs to reconcile.
+func (tc *TestContext) WithT(t *testing.T) *WithT {
+	t.Helper()
+
+	g := NewWithT(t)
+	g.SetDefaultEventuallyTimeout(DefaultWaitTimeout)
+	g.SetDefaultEventuallyPollingInterval(DefaultPollingInterval)
+
+	return g
+}
+
+func (tc *TestContext) List(
+	gvk schema.GroupVersionKind,
+	option ...client.ListOption,
+) func() ([]unstructured.Unstructured, error) {
+	return func() ([]unstructured.Unstructured, error) {
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(gvk)
+
+		err := tc.client.List(tc.ctx, &items, option...)
+		if err != nil {
+			return nil, err
+		}
+
+		return items.Items, nil
+	}
+}
+
+func (tc *TestContext) Get(
+	gvk schema.GroupVersionKind,
+	ns string,
+	name string,
+	option ...client.GetOption,
+) func() (*unstructured.Unstructured, error) {
+	return func() (*unstructured.Unstructured, error) {
+		u := unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+
+		err := tc.client.Get(tc.ctx, client.ObjectKey{Namespace: ns, Name: name}, &u, option...)
+		if err != nil {
+			return nil, err
+		}
+
+		return &u, nil
+	}
+}
+
+func (tc *TestContext) Delete(
+	gvk schema.GroupVersionKind,
+	ns string,
+	name string,
+	option ...client.DeleteOption,
+) func() error {
+	return func() error {
+		u := resources.GvkToUnstructured(gvk)
+		u.SetName(name)
+		u.SetNamespace(ns)
+
+		err := tc.client.Delete(tc.ctx, u, option...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func (tc *TestContext) Update(
+	obj client.Object,
+	fn func(obj *unstructured.Unstructured) error,
+	option ...client.GetOption,
+) func() (*unstructured.Unstructured, error) {
+	return func() (*unstructured.Unstructured, error) {
+		if err := tc.client.Get(tc.ctx, client.ObjectKeyFromObject(obj), obj, option...); err != nil {
+			return nil, fmt.Errorf("failed to fetch resource: %w", err)
+		}
+
+		in, err := resources.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to unstructured: %w", err)
+		}
+
+		if err := fn(in); err != nil {
+			return nil, fmt.Errorf("failed to apply function: %w", err)
+		}
+
+		if err := tc.client.Update(tc.ctx, in); err != nil {
+			return nil, fmt.Errorf("failed to update resource: %w", err)
+		}
+
+		return in, nil
+	}
+}
+
+func (tc *TestContext) MergePatch(
+	obj client.Object,
+	patch []byte,
+) func() (*unstructured.Unstructured, error) {
+	return func() (*unstructured.Unstructured, error) {
+		u, err := resources.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		err = tc.client.Patch(tc.ctx, u, client.RawPatch(types.MergePatchType, patch))
+		if err != nil {
+			return nil, err
+		}
+
+		return u, nil
+	}
+}
+
+// UpdateComponents refreshes dsc from the cluster, applies fn to its components and updates it. It is
+// typically used to enable or disable a component:
+//
+//	g.Eventually(tc.UpdateComponents(dsc, func(c *dscv1.Components) {
+//		c.Dashboard.ManagementState = operatorv1.Managed
+//	})).ShouldNot(HaveOccurred())
+func (tc *TestContext) UpdateComponents(dsc *dscv1.DataScienceCluster, fn func(c *dscv1.Components)) func() error {
+	return func() error {
+		err := tc.client.Get(tc.ctx, types.NamespacedName{Name: dsc.Name}, dsc)
+		if err != nil {
+			return err
+		}
+
+		fn(&dsc.Spec.Components)
+
+		err = tc.client.Update(tc.ctx, dsc)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
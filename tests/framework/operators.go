@@ -0,0 +1,197 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	ofapi "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnsureOperators installs the operators in ns in parallel, see EnsureOperator.
+func EnsureOperators(ctx context.Context, cli client.Client, ns string, names ...string) error {
+	var errors *multierror.Error
+	c := make(chan error)
+
+	for _, name := range names {
+		go func(name string) {
+			c <- EnsureOperator(ctx, cli, name, ns)
+		}(name)
+	}
+
+	for range len(names) {
+		errors = multierror.Append(errors, <-c)
+	}
+
+	return errors.ErrorOrNil()
+}
+
+// EnsureOperator installs the operator from its package through OLM, creating the Subscription if needed
+// and approving its InstallPlan, then waits for its ClusterServiceVersion to succeed.
+func EnsureOperator(ctx context.Context, cli client.Client, name string, ns string) error {
+	// it creates subscription under the hood if needed
+	plan, err := getInstallPlan(ctx, cli, name, ns)
+	if err != nil {
+		return err
+	}
+
+	// in CI InstallPlan is in Manual mode
+	if !plan.Spec.Approved {
+		err = approveInstallPlan(ctx, cli, plan)
+		if err != nil {
+			return err
+		}
+	}
+
+	return waitCSV(ctx, cli, name, ns)
+}
+
+// GetCSV returns the ClusterServiceVersion of namespace whose name contains name.
+func GetCSV(ctx context.Context, cli client.Client, name string, namespace string) (*ofapi.ClusterServiceVersion, error) {
+	isMatched := func(csv *ofapi.ClusterServiceVersion, name string) bool {
+		return strings.Contains(csv.ObjectMeta.Name, name)
+	}
+
+	opt := &client.ListOptions{
+		Namespace: namespace,
+	}
+	csvList := &ofapi.ClusterServiceVersionList{}
+	err := cli.List(ctx, csvList, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// do not use range Items to avoid pointer to the loop variable
+	for i := range len(csvList.Items) {
+		csv := &csvList.Items[i]
+		if isMatched(csv, name) {
+			return csv, nil
+		}
+	}
+
+	return nil, k8serr.NewNotFound(schema.GroupResource{}, name)
+}
+
+// Use existing or create a new one.
+func getSubscription(ctx context.Context, cli client.Client, name string, ns string) (*ofapi.Subscription, error) {
+	sub := &ofapi.Subscription{}
+	key := types.NamespacedName{
+		Namespace: ns,
+		Name:      name,
+	}
+
+	err := cli.Get(ctx, key, sub)
+	if k8serr.IsNotFound(err) {
+		sub = NewSubscription(name, ns)
+
+		if err := cli.Create(ctx, sub); err != nil {
+			return nil, fmt.Errorf("error creating subscription: %w", err)
+		}
+
+		return sub, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func waitCSV(ctx context.Context, cli client.Client, name string, ns string) error {
+	isReady := func(ctx context.Context) (bool, error) {
+		csv, err := GetCSV(ctx, cli, name, ns)
+		if k8serr.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		return csv.Status.Phase == ofapi.CSVPhaseSucceeded, nil
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, CSVWaitTimeout, false, isReady)
+	if err != nil {
+		return fmt.Errorf("error installing %s CSV: %w", name, err)
+	}
+
+	return nil
+}
+
+func getInstallPlanName(ctx context.Context, cli client.Client, name string, ns string) (string, error) {
+	sub := &ofapi.Subscription{}
+
+	// waits for InstallPlanRef and copies value out of the closure
+	err := wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, DefaultWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		_sub, err := getSubscription(ctx, cli, name, ns)
+		if err != nil {
+			return false, err
+		}
+		*sub = *_sub
+		return sub.Status.InstallPlanRef != nil, nil
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error creating subscription %s: %w", name, err)
+	}
+
+	return sub.Status.InstallPlanRef.Name, nil
+}
+
+func getInstallPlan(ctx context.Context, cli client.Client, name string, ns string) (*ofapi.InstallPlan, error) {
+	// it creates subscription under the hood if needed and waits for InstallPlan reference
+	planName, err := getInstallPlanName(ctx, cli, name, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ofapi.InstallPlan{}
+	key := types.NamespacedName{
+		Namespace: ns,
+		Name:      planName,
+	}
+
+	err = cli.Get(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func approveInstallPlan(ctx context.Context, cli client.Client, plan *ofapi.InstallPlan) error {
+	obj := &ofapi.InstallPlan{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "InstallPlan",
+			APIVersion: "operators.coreos.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      plan.ObjectMeta.Name,
+			Namespace: plan.ObjectMeta.Namespace,
+		},
+		Spec: ofapi.InstallPlanSpec{
+			Approved:                   true,
+			Approval:                   ofapi.ApprovalAutomatic,
+			ClusterServiceVersionNames: plan.Spec.ClusterServiceVersionNames,
+		},
+	}
+	force := true
+	opt := &client.PatchOptions{
+		FieldManager: "e2e-test-dsc",
+		Force:        &force,
+	}
+
+	err := cli.Patch(ctx, obj, client.Apply, opt)
+	if err != nil {
+		return fmt.Errorf("error patching InstallPlan %s: %w", obj.ObjectMeta.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// WaitForDeployment waits until the deployment is available with the given number of ready replicas.
+func WaitForDeployment(ctx context.Context, cli client.Client, ns string, name string, replicas int32) error {
+	return wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, OperatorReadyTimeout, false, func(ctx context.Context) (bool, error) {
+		deployment := appsv1.Deployment{}
+
+		err := cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, &deployment)
+		if err != nil {
+			if k8serr.IsNotFound(err) {
+				return false, nil
+			}
+			log.Printf("Failed to get %s deployment", name)
+
+			return false, err
+		}
+
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == appsv1.DeploymentAvailable {
+				if condition.Status == corev1.ConditionTrue && deployment.Status.ReadyReplicas == replicas {
+					return true, nil
+				}
+			}
+		}
+		log.Printf("Error in %s deployment", name)
+
+		return false, nil
+	})
+}
+
+// WaitForCRD waits until the CustomResourceDefinition is established.
+func WaitForCRD(ctx context.Context, cli client.Client, name string) error {
+	return wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, CRDReadyTimeout, false, func(ctx context.Context) (bool, error) {
+		crd := apiextv1.CustomResourceDefinition{}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: name}, &crd)
+		if err != nil {
+			if k8serr.IsNotFound(err) {
+				return false, nil
+			}
+			log.Printf("Failed to get CRD %s", name)
+
+			return false, err
+		}
+
+		for _, condition := range crd.Status.Conditions {
+			if condition.Type == apiextv1.Established {
+				if condition.Status == apiextv1.ConditionTrue {
+					return true, nil
+				}
+			}
+		}
+		log.Printf("Error to get CRD %s condition's matching", name)
+
+		return false, nil
+	})
+}
+
+// WaitForDSCReady waits until the DataScienceCluster reaches the Ready phase, which happens once all of
+// its enabled components are ready.
+func WaitForDSCReady(ctx context.Context, cli client.Client, name string) error {
+	err := wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, DefaultWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		dsc := dscv1.DataScienceCluster{}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: name}, &dsc)
+		if err != nil {
+			return false, err
+		}
+
+		return dsc.Status.Phase == status.PhaseReady, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting Ready state for DSC %v: %w", name, err)
+	}
+
+	return nil
+}
+
+// WaitForDSCCondition waits until the condition of the DataScienceCluster has the given status.
+func WaitForDSCCondition(
+	ctx context.Context,
+	cli client.Client,
+	name string,
+	conditionType conditionsv1.ConditionType,
+	conditionStatus corev1.ConditionStatus,
+) error {
+	err := wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, DefaultWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		dsc := dscv1.DataScienceCluster{}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: name}, &dsc)
+		if err != nil {
+			return false, err
+		}
+
+		return conditionsv1.IsStatusConditionPresentAndEqual(dsc.Status.Conditions, conditionType, conditionStatus), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting condition %s to be %s for DSC %v: %w", conditionType, conditionStatus, name, err)
+	}
+
+	return nil
+}
+
+// WaitForDSCICondition waits until the condition of the DSCInitialization has the given status.
+func WaitForDSCICondition(
+	ctx context.Context,
+	cli client.Client,
+	name string,
+	conditionType conditionsv1.ConditionType,
+	conditionStatus corev1.ConditionStatus,
+) error {
+	err := wait.PollUntilContextTimeout(ctx, DefaultRetryInterval, DefaultWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		dsci := dsciv1.DSCInitialization{}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: name}, &dsci)
+		if err != nil {
+			return false, err
+		}
+
+		return conditionsv1.IsStatusConditionPresentAndEqual(dsci.Status.Conditions, conditionType, conditionStatus), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting condition %s to be %s for DSCI %v: %w", conditionType, conditionStatus, name, err)
+	}
+
+	return nil
+}